@@ -0,0 +1,691 @@
+// Package apis provides types/client for making requests to /apis.
+package apis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+const path = "/apis"
+
+// Client handles api operations.
+type Client struct {
+	restClient *rest.Client
+}
+
+// NewClient returns a new instance of Client.
+func NewClient(restClient *rest.Client) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// Create sends a POST request to /apis.
+func (c *Client) Create(
+	ctx context.Context,
+	api API,
+	opts ...rest.RequestOption,
+) (API, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s", c.restClient.BaseURL(), path),
+		apiWrapper{API: api},
+	)
+	if err != nil {
+		return API{}, err
+	}
+
+	var response apiWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.API, err
+}
+
+// Get sends a GET request to /apis/:apiId.
+func (c *Client) Get(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (API, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		c.apiURL(id),
+		nil,
+	)
+	if err != nil {
+		return API{}, err
+	}
+
+	var response apiWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.API, err
+}
+
+// GetAll sends a GET request to /apis.
+func (c *Client) GetAll(
+	ctx context.Context,
+	req GetAllAPIsRequest,
+	opts ...rest.RequestOption,
+) ([]API, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s", c.restClient.BaseURL(), path),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	q := r.URL.Query()
+	if req.Since != nil {
+		q.Add("since", *req.Since)
+	}
+	if req.Until != nil {
+		q.Add("until", *req.Until)
+	}
+	if req.CreatedBy != nil {
+		q.Add("createdBy", *req.CreatedBy)
+	}
+	if req.UpdatedBy != nil {
+		q.Add("updatedBy", *req.UpdatedBy)
+	}
+	if req.IsPublic != nil {
+		q.Add("isPublic", strconv.FormatBool(*req.IsPublic))
+	}
+	if req.Name != nil {
+		q.Add("name", *req.Name)
+	}
+	if req.Summary != nil {
+		q.Add("summary", *req.Summary)
+	}
+	if req.Description != nil {
+		q.Add("description", *req.Description)
+	}
+	if req.Sort != nil {
+		q.Add("sort", *req.Sort)
+	}
+	if req.Direction != nil {
+		q.Add("direction", *req.Direction)
+	}
+	r.URL.RawQuery = q.Encode()
+
+	var response apiWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.APIs, err
+}
+
+// Update sends a PUT request to /apis/:apiId.
+func (c *Client) Update(
+	ctx context.Context,
+	id string,
+	api API,
+	opts ...rest.RequestOption,
+) (API, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		c.apiURL(id),
+		apiWrapper{API: api},
+	)
+	if err != nil {
+		return API{}, err
+	}
+
+	var response apiWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.API, err
+}
+
+// Delete sends a DELETE request to /apis/:apiId.
+func (c *Client) Delete(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (API, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		c.apiURL(id),
+		nil,
+	)
+	if err != nil {
+		return API{}, err
+	}
+
+	var response apiWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.API, err
+}
+
+// CreateVersion sends a POST request to /apis/:apiId/versions.
+func (c *Client) CreateVersion(
+	ctx context.Context,
+	apiID string,
+	version Version,
+	opts ...rest.RequestOption,
+) (Version, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/versions", c.apiURL(apiID)),
+		versionWrapper{Version: version},
+	)
+	if err != nil {
+		return Version{}, err
+	}
+
+	var response versionWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Version, err
+}
+
+// GetVersion sends a GET request to /apis/:apiId/versions/:versionId.
+func (c *Client) GetVersion(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) (Version, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		c.versionURL(apiID, versionID),
+		nil,
+	)
+	if err != nil {
+		return Version{}, err
+	}
+
+	var response versionWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Version, err
+}
+
+// GetAllVersions sends a GET request to /apis/:apiId/versions.
+func (c *Client) GetAllVersions(
+	ctx context.Context,
+	apiID string,
+	opts ...rest.RequestOption,
+) ([]Version, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/versions", c.apiURL(apiID)),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response versionWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Versions, err
+}
+
+// UpdateVersion sends a PUT request to /apis/:apiId/versions/:versionId.
+func (c *Client) UpdateVersion(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	version Version,
+	opts ...rest.RequestOption,
+) (Version, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		c.versionURL(apiID, versionID),
+		versionWrapper{Version: version},
+	)
+	if err != nil {
+		return Version{}, err
+	}
+
+	var response versionWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Version, err
+}
+
+// DeleteVersion sends a DELETE request to /apis/:apiId/versions/:versionId.
+func (c *Client) DeleteVersion(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) (Version, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		c.versionURL(apiID, versionID),
+		nil,
+	)
+	if err != nil {
+		return Version{}, err
+	}
+
+	var response versionWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Version, err
+}
+
+// CreateRelease sends a POST request to /apis/:apiId/versions/:versionId/releases.
+func (c *Client) CreateRelease(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	release Release,
+	opts ...rest.RequestOption,
+) (Release, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/releases", c.versionURL(apiID, versionID)),
+		releaseWrapper{Release: release},
+	)
+	if err != nil {
+		return Release{}, err
+	}
+
+	var response releaseWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Release, err
+}
+
+// GetRelease sends a GET request to /apis/:apiId/versions/:versionId/releases/:releaseId.
+func (c *Client) GetRelease(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	releaseID string,
+	opts ...rest.RequestOption,
+) (Release, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/releases/%s", c.versionURL(apiID, versionID), releaseID),
+		nil,
+	)
+	if err != nil {
+		return Release{}, err
+	}
+
+	var response releaseWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Release, err
+}
+
+// GetAllReleases sends a GET request to /apis/:apiId/versions/:versionId/releases.
+func (c *Client) GetAllReleases(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]Release, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/releases", c.versionURL(apiID, versionID)),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response releaseWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Releases, err
+}
+
+// UpdateRelease sends a PUT request to /apis/:apiId/versions/:versionId/releases/:releaseId.
+func (c *Client) UpdateRelease(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	releaseID string,
+	release Release,
+	opts ...rest.RequestOption,
+) (Release, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s/releases/%s", c.versionURL(apiID, versionID), releaseID),
+		releaseWrapper{Release: release},
+	)
+	if err != nil {
+		return Release{}, err
+	}
+
+	var response releaseWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Release, err
+}
+
+// DeleteRelease sends a DELETE request to /apis/:apiId/versions/:versionId/releases/:releaseId.
+func (c *Client) DeleteRelease(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	releaseID string,
+	opts ...rest.RequestOption,
+) (Release, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s/releases/%s", c.versionURL(apiID, versionID), releaseID),
+		nil,
+	)
+	if err != nil {
+		return Release{}, err
+	}
+
+	var response releaseWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Release, err
+}
+
+// CreateSchema sends a POST request to /apis/:apiId/versions/:versionId/schemas.
+func (c *Client) CreateSchema(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	schema Schema,
+	opts ...rest.RequestOption,
+) (Schema, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/schemas", c.versionURL(apiID, versionID)),
+		schemaWrapper{Schema: schema},
+	)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	var response schemaWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Schema, err
+}
+
+// GetSchema sends a GET request to /apis/:apiId/versions/:versionId/schemas/:schemaId.
+func (c *Client) GetSchema(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	schemaID string,
+	opts ...rest.RequestOption,
+) (Schema, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas/%s", c.versionURL(apiID, versionID), schemaID),
+		nil,
+	)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	var response schemaWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Schema, err
+}
+
+// UpdateSchema sends a PUT request to /apis/:apiId/versions/:versionId/schemas/:schemaId.
+func (c *Client) UpdateSchema(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	schemaID string,
+	schema Schema,
+	opts ...rest.RequestOption,
+) (Schema, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s/schemas/%s", c.versionURL(apiID, versionID), schemaID),
+		schemaWrapper{Schema: schema},
+	)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	var response schemaWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Schema, err
+}
+
+// CreateCollectionFromSchema sends a POST request to
+// /apis/:apiId/versions/:versionId/schemas/:schemaId/collections.
+func (c *Client) CreateCollectionFromSchema(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	schemaID string,
+	req CreateCollectionFromSchemaRequest,
+	opts ...rest.RequestOption,
+) (CreateCollectionFromSchemaResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/schemas/%s/collections", c.versionURL(apiID, versionID), schemaID),
+		req,
+	)
+	if err != nil {
+		return CreateCollectionFromSchemaResponse{}, err
+	}
+
+	var response CreateCollectionFromSchemaResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// CreateRelations sends a POST request to /apis/:apiId/versions/:versionId/relations.
+func (c *Client) CreateRelations(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	req CreateRelationsRequest,
+	opts ...rest.RequestOption,
+) (CreateRelationsResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/relations", c.versionURL(apiID, versionID)),
+		req,
+	)
+	if err != nil {
+		return CreateRelationsResponse{}, err
+	}
+
+	var response CreateRelationsResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetRelations sends a GET request to /apis/:apiId/versions/:versionId/relations.
+func (c *Client) GetRelations(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) (Relations, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/relations", c.versionURL(apiID, versionID)),
+		nil,
+	)
+	if err != nil {
+		return Relations{}, err
+	}
+
+	var response relationsWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Relations, err
+}
+
+// GetDocumentation sends a GET request to /apis/:apiId/versions/:versionId/documentation.
+func (c *Client) GetDocumentation(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]Documentation, error) {
+	return getRelated[Documentation](ctx, c, apiID, versionID, RelationTypeDocumentation, opts)
+}
+
+// GetContractTests sends a GET request to /apis/:apiId/versions/:versionId/contracttest.
+func (c *Client) GetContractTests(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]ContractTest, error) {
+	return getRelated[ContractTest](ctx, c, apiID, versionID, RelationTypeContractTest, opts)
+}
+
+// GetIntegrationTests sends a GET request to /apis/:apiId/versions/:versionId/integrationtest.
+func (c *Client) GetIntegrationTests(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]IntegrationTest, error) {
+	return getRelated[IntegrationTest](ctx, c, apiID, versionID, RelationTypeIntegrationTest, opts)
+}
+
+// GetTestSuites sends a GET request to /apis/:apiId/versions/:versionId/testsuite.
+func (c *Client) GetTestSuites(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]TestSuite, error) {
+	return getRelated[TestSuite](ctx, c, apiID, versionID, RelationTypeTestSuite, opts)
+}
+
+// GetTests sends a GET request to /apis/:apiId/versions/:versionId/test.
+func (c *Client) GetTests(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]Test, error) {
+	return getRelated[Test](ctx, c, apiID, versionID, RelationTypeTest, opts)
+}
+
+// GetMonitors sends a GET request to /apis/:apiId/versions/:versionId/monitor.
+func (c *Client) GetMonitors(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]Monitor, error) {
+	return getRelated[Monitor](ctx, c, apiID, versionID, RelationTypeMonitor, opts)
+}
+
+// GetEnvironments sends a GET request to /apis/:apiId/versions/:versionId/environment.
+func (c *Client) GetEnvironments(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	opts ...rest.RequestOption,
+) ([]Environment, error) {
+	return getRelated[Environment](ctx, c, apiID, versionID, RelationTypeEnvironment, opts)
+}
+
+// SyncWithSchema sends a PUT request to /apis/:apiId/versions/:versionId/:relationType/:entityId/syncWithSchema,
+// updating the relation of the given type and entity id to match the api version's schema.
+func (c *Client) SyncWithSchema(
+	ctx context.Context,
+	apiID string,
+	versionID string,
+	relationType string,
+	entityID string,
+	opts ...rest.RequestOption,
+) error {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s/%s/%s/syncWithSchema", c.versionURL(apiID, versionID), relationType, entityID),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Success bool `json:"success"`
+	}
+	return c.restClient.DoRequest(r, &response, opts...)
+}
+
+// getRelated sends a GET request to /apis/:apiId/versions/:versionId/:relationType, returning
+// the relations of the given type.
+func getRelated[T any](
+	ctx context.Context,
+	c *Client,
+	apiID string,
+	versionID string,
+	relationType string,
+	opts []rest.RequestOption,
+) ([]T, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/%s", c.versionURL(apiID, versionID), relationType),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the relations are decoded, other top level keys (e.g. meta) may have any shape.
+	var response map[string]json.RawMessage
+	if err = c.restClient.DoRequest(r, &response, opts...); err != nil {
+		return nil, err
+	}
+
+	raw, ok := response[relationType]
+	if !ok {
+		return nil, nil
+	}
+
+	var relations []T
+	err = json.Unmarshal(raw, &relations)
+
+	return relations, err
+}
+
+func (c *Client) apiURL(apiID string) string {
+	return fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, apiID)
+}
+
+func (c *Client) versionURL(apiID, versionID string) string {
+	return fmt.Sprintf("%s/versions/%s", c.apiURL(apiID), versionID)
+}
@@ -0,0 +1,365 @@
+// Package apis provides types/client for making requests to /apis.
+package apis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/actatum/postman-client/rest"
+	"github.com/actatum/postman-client/testdata"
+)
+
+func TestClient_APIs(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+
+	rc := rest.NewClient(testdata.TestAPIKey)
+	c := NewClient(rc)
+	inWorkspace := rest.WithWorkspace(testdata.TestWorkspaceID)
+
+	t.Run("full lifecycle", func(t *testing.T) {
+		ctx := context.Background()
+
+		// Create success
+		api, err := c.Create(ctx, API{
+			Name:    "Test API",
+			Summary: "An api for testing the postman client.",
+		}, inWorkspace)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Get Not Found
+		_, err = c.Get(ctx, "123")
+		if !errors.Is(err, rest.ErrNotFound) {
+			t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+		}
+
+		// Get recently created api
+		api, err = c.Get(ctx, api.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// GetAll
+		_, err = c.GetAll(ctx, GetAllAPIsRequest{}, inWorkspace)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Update
+		_, err = c.Update(ctx, api.ID, API{Name: "Test API Updated"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Versions
+		version, err := c.CreateVersion(ctx, api.ID, Version{Name: "1.0.0"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetAllVersions(ctx, api.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		// Schemas
+		schema, err := c.CreateSchema(ctx, api.ID, version.ID, Schema{
+			Type:     SchemaTypeOpenAPI3,
+			Language: SchemaLanguageJSON,
+			Schema:   testdata.APISecurityValidationSchemaJSON,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetSchema(ctx, api.ID, version.ID, schema.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		// Relations
+		if _, err = c.GetRelations(ctx, api.ID, version.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetDocumentation(ctx, api.ID, version.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		// Delete
+		if _, err = c.DeleteVersion(ctx, api.ID, version.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.Delete(ctx, api.ID); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClient_Requests(t *testing.T) {
+	var gotMethod, gotPath, gotQuery, gotBody string
+	var response string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotMethod, gotPath, gotQuery, gotBody = r.Method, r.URL.Path, r.URL.RawQuery, string(b)
+		w.Header().Set("Content-Type", "application/json")
+		if response == "" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"name":"instanceNotFoundError","message":"not found"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	ctx := context.Background()
+	name := "Test API"
+
+	tests := []struct {
+		name      string
+		call      func() (interface{}, error)
+		response  string
+		wantReq   string
+		wantQuery string
+		wantBody  string
+		want      interface{}
+		wantErr   error
+	}{
+		{
+			name: "create api",
+			call: func() (interface{}, error) {
+				return c.Create(ctx, API{Name: "Test API"}, rest.WithWorkspace("ws-1"))
+			},
+			response:  `{"api":{"id":"api-1","name":"Test API"}}`,
+			wantReq:   "POST /apis",
+			wantQuery: "workspace=ws-1",
+			wantBody:  `"api":{"name":"Test API"`,
+			want:      API{ID: "api-1", Name: "Test API"},
+		},
+		{
+			name:     "get api",
+			call:     func() (interface{}, error) { return c.Get(ctx, "api-1") },
+			response: `{"api":{"id":"api-1","name":"Test API","isPublic":true}}`,
+			wantReq:  "GET /apis/api-1",
+			want:     API{ID: "api-1", Name: "Test API", IsPublic: true},
+		},
+		{
+			name:    "get api not found",
+			call:    func() (interface{}, error) { return c.Get(ctx, "123") },
+			wantReq: "GET /apis/123",
+			want:    API{},
+			wantErr: rest.ErrNotFound,
+		},
+		{
+			name:      "get all apis",
+			call:      func() (interface{}, error) { return c.GetAll(ctx, GetAllAPIsRequest{Name: &name}) },
+			response:  `{"apis":[{"id":"api-1"},{"id":"api-2"}]}`,
+			wantReq:   "GET /apis",
+			wantQuery: "name=Test+API",
+			want:      []API{{ID: "api-1"}, {ID: "api-2"}},
+		},
+		{
+			name:     "update api",
+			call:     func() (interface{}, error) { return c.Update(ctx, "api-1", API{Name: "Renamed"}) },
+			response: `{"api":{"id":"api-1","name":"Renamed"}}`,
+			wantReq:  "PUT /apis/api-1",
+			wantBody: `"api":{"name":"Renamed"`,
+			want:     API{ID: "api-1", Name: "Renamed"},
+		},
+		{
+			name:     "delete api",
+			call:     func() (interface{}, error) { return c.Delete(ctx, "api-1") },
+			response: `{"api":{"id":"api-1"}}`,
+			wantReq:  "DELETE /apis/api-1",
+			want:     API{ID: "api-1"},
+		},
+		{
+			name:     "create version",
+			call:     func() (interface{}, error) { return c.CreateVersion(ctx, "api-1", Version{Name: "1.0.0"}) },
+			response: `{"version":{"id":"v-1","name":"1.0.0","api":"api-1"}}`,
+			wantReq:  "POST /apis/api-1/versions",
+			wantBody: `"version":{"name":"1.0.0"`,
+			want:     Version{ID: "v-1", Name: "1.0.0", API: "api-1"},
+		},
+		{
+			name:     "get version",
+			call:     func() (interface{}, error) { return c.GetVersion(ctx, "api-1", "v-1") },
+			response: `{"version":{"id":"v-1","schema":["s-1"]}}`,
+			wantReq:  "GET /apis/api-1/versions/v-1",
+			want:     Version{ID: "v-1", Schema: []string{"s-1"}},
+		},
+		{
+			name:     "get all versions",
+			call:     func() (interface{}, error) { return c.GetAllVersions(ctx, "api-1") },
+			response: `{"versions":[{"id":"v-1"}]}`,
+			wantReq:  "GET /apis/api-1/versions",
+			want:     []Version{{ID: "v-1"}},
+		},
+		{
+			name:     "update version",
+			call:     func() (interface{}, error) { return c.UpdateVersion(ctx, "api-1", "v-1", Version{Name: "1.0.1"}) },
+			response: `{"version":{"id":"v-1","name":"1.0.1"}}`,
+			wantReq:  "PUT /apis/api-1/versions/v-1",
+			wantBody: `"version":{"name":"1.0.1"`,
+			want:     Version{ID: "v-1", Name: "1.0.1"},
+		},
+		{
+			name:     "delete version",
+			call:     func() (interface{}, error) { return c.DeleteVersion(ctx, "api-1", "v-1") },
+			response: `{"version":{"id":"v-1"}}`,
+			wantReq:  "DELETE /apis/api-1/versions/v-1",
+			want:     Version{ID: "v-1"},
+		},
+		{
+			name: "create release",
+			call: func() (interface{}, error) {
+				return c.CreateRelease(ctx, "api-1", "v-1", Release{Name: "GA", Notes: "First release"})
+			},
+			response: `{"release":{"id":"r-1","name":"GA","notes":"First release"}}`,
+			wantReq:  "POST /apis/api-1/versions/v-1/releases",
+			wantBody: `"release":{"name":"GA","notes":"First release"`,
+			want:     Release{ID: "r-1", Name: "GA", Notes: "First release"},
+		},
+		{
+			name:     "get release",
+			call:     func() (interface{}, error) { return c.GetRelease(ctx, "api-1", "v-1", "r-1") },
+			response: `{"release":{"id":"r-1","name":"GA"}}`,
+			wantReq:  "GET /apis/api-1/versions/v-1/releases/r-1",
+			want:     Release{ID: "r-1", Name: "GA"},
+		},
+		{
+			name:     "get all releases",
+			call:     func() (interface{}, error) { return c.GetAllReleases(ctx, "api-1", "v-1") },
+			response: `{"releases":[{"id":"r-1"},{"id":"r-2"}]}`,
+			wantReq:  "GET /apis/api-1/versions/v-1/releases",
+			want:     []Release{{ID: "r-1"}, {ID: "r-2"}},
+		},
+		{
+			name: "update release",
+			call: func() (interface{}, error) {
+				return c.UpdateRelease(ctx, "api-1", "v-1", "r-1", Release{Name: "GA 2"})
+			},
+			response: `{"release":{"id":"r-1","name":"GA 2"}}`,
+			wantReq:  "PUT /apis/api-1/versions/v-1/releases/r-1",
+			wantBody: `"release":{"name":"GA 2"`,
+			want:     Release{ID: "r-1", Name: "GA 2"},
+		},
+		{
+			name:     "delete release",
+			call:     func() (interface{}, error) { return c.DeleteRelease(ctx, "api-1", "v-1", "r-1") },
+			response: `{"release":{"id":"r-1"}}`,
+			wantReq:  "DELETE /apis/api-1/versions/v-1/releases/r-1",
+			want:     Release{ID: "r-1"},
+		},
+		{
+			name: "create schema",
+			call: func() (interface{}, error) {
+				return c.CreateSchema(ctx, "api-1", "v-1", Schema{
+					Type:     SchemaTypeOpenAPI3,
+					Language: SchemaLanguageJSON,
+					Schema:   "{}",
+				})
+			},
+			response: `{"schema":{"id":"s-1","type":"openapi3","language":"json","apiVersion":"v-1"}}`,
+			wantReq:  "POST /apis/api-1/versions/v-1/schemas",
+			wantBody: `"schema":{"type":"openapi3","language":"json","schema":"{}"`,
+			want:     Schema{ID: "s-1", Type: SchemaTypeOpenAPI3, Language: SchemaLanguageJSON, APIVersion: "v-1"},
+		},
+		{
+			name:     "get schema",
+			call:     func() (interface{}, error) { return c.GetSchema(ctx, "api-1", "v-1", "s-1") },
+			response: `{"schema":{"id":"s-1","schema":"{}"}}`,
+			wantReq:  "GET /apis/api-1/versions/v-1/schemas/s-1",
+			want:     Schema{ID: "s-1", Schema: "{}"},
+		},
+		{
+			name: "update schema",
+			call: func() (interface{}, error) {
+				return c.UpdateSchema(ctx, "api-1", "v-1", "s-1", Schema{Schema: `{"openapi":"3.0.0"}`})
+			},
+			response: `{"schema":{"id":"s-1","schema":"{\"openapi\":\"3.0.0\"}"}}`,
+			wantReq:  "PUT /apis/api-1/versions/v-1/schemas/s-1",
+			wantBody: `"schema":{"schema":"{\"openapi\":\"3.0.0\"}"`,
+			want:     Schema{ID: "s-1", Schema: `{"openapi":"3.0.0"}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response = tt.response
+			got, err := tt.call()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error got = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if req := gotMethod + " " + gotPath; req != tt.wantReq {
+				t.Errorf("request got = %v, want %v", req, tt.wantReq)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("query got = %v, want %v", gotQuery, tt.wantQuery)
+			}
+			if !strings.Contains(gotBody, tt.wantBody) {
+				t.Errorf("body got = %v, want it to contain %v", gotBody, tt.wantBody)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("result got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetRelated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/api-1/versions/version-1/monitor":
+			_, _ = w.Write([]byte(`{"monitor":[{"id":"1","name":"Nightly","monitorId":"m-1"}],"meta":{"total":1}}`))
+		case "/apis/api-1/versions/version-1/documentation":
+			_, _ = w.Write([]byte(`{"documentation":[{"id":"2","name":"Docs"},{"id":"3","name":"More docs"}]}`))
+		case "/apis/api-1/versions/version-1/testsuite/entity-1/syncWithSchema":
+			if r.Method != http.MethodPut {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			_, _ = w.Write([]byte(`{"success":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	ctx := context.Background()
+
+	monitors, err := c.GetMonitors(ctx, "api-1", "version-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 1 || monitors[0].MonitorID != "m-1" {
+		t.Errorf("GetMonitors() got = %+v, want monitor m-1", monitors)
+	}
+
+	docs, err := c.GetDocumentation(ctx, "api-1", "version-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 2 {
+		t.Errorf("len(GetDocumentation()) got = %v, want %v", len(docs), 2)
+	}
+
+	if err = c.SyncWithSchema(ctx, "api-1", "version-1", RelationTypeTestSuite, "entity-1"); err != nil {
+		t.Errorf("SyncWithSchema() error got = %v, want nil", err)
+	}
+
+	if _, err = c.GetTests(ctx, "api-1", "missing"); !errors.Is(err, rest.ErrNotFound) {
+		t.Errorf("GetTests() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+}
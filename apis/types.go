@@ -0,0 +1,277 @@
+// Package apis provides types/client for making requests to /apis.
+package apis
+
+import (
+	"time"
+
+	"github.com/actatum/postman-client/apisecurity"
+)
+
+// Possible values for schema types.
+const (
+	SchemaTypeOpenAPI3 = apisecurity.OpenAPIV3
+	SchemaTypeOpenAPI2 = apisecurity.OpenAPIV2
+	SchemaTypeOpenAPI1 = "openapi1"
+	SchemaTypeRAML     = "raml"
+	SchemaTypeRAML1    = "raml1"
+	SchemaTypeGraphQL  = "graphql"
+	SchemaTypeWSDL1    = "wsdl1"
+	SchemaTypeWSDL2    = "wsdl2"
+)
+
+// Possible values for schema languages.
+const (
+	SchemaLanguageJSON    = apisecurity.LanguageJSON
+	SchemaLanguageYAML    = apisecurity.LanguageYAML
+	SchemaLanguageGraphQL = "graphql"
+	SchemaLanguageXML     = "xml"
+)
+
+// Possible values for relation types.
+const (
+	RelationTypeDocumentation   = "documentation"
+	RelationTypeContractTest    = "contracttest"
+	RelationTypeIntegrationTest = "integrationtest"
+	RelationTypeTestSuite       = "testsuite"
+	RelationTypeTest            = "test"
+	RelationTypeMonitor         = "monitor"
+	RelationTypeMock            = "mock"
+	RelationTypeEnvironment     = "environment"
+)
+
+// GetAllAPIsRequest is the request type for GET /apis.
+type GetAllAPIsRequest struct {
+	// Return only apis updated since the given time, in ISO 8601 format.
+	Since *string
+	// Return only apis updated until the given time, in ISO 8601 format.
+	Until *string
+	// Return only apis created by the given user id.
+	CreatedBy *string
+	// Return only apis updated by the given user id.
+	UpdatedBy *string
+	// Return only public (true) or private (false) apis.
+	IsPublic *bool
+	// Return only apis whose name includes the given value.
+	Name *string
+	// Return only apis whose summary includes the given value.
+	Summary *string
+	// Return only apis whose description includes the given value.
+	Description *string
+	// Sort the results by the given value, e.g. 'createdAt' or 'updatedAt'.
+	Sort *string
+	// Sort in ascending ('asc') or descending ('desc') order.
+	Direction *string
+}
+
+// API ...
+type API struct {
+	ID          string    `json:"id,omitempty"` // Output only.
+	Name        string    `json:"name,omitempty"`
+	Summary     string    `json:"summary,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Owner       string    `json:"owner,omitempty"`     // Output only.
+	CreatedBy   string    `json:"createdBy,omitempty"` // Output only.
+	UpdatedBy   string    `json:"updatedBy,omitempty"` // Output only.
+	IsPublic    bool      `json:"isPublic,omitempty"`  // Output only.
+	CreatedAt   time.Time `json:"createdAt,omitempty"` // Output only.
+	UpdatedAt   time.Time `json:"updatedAt,omitempty"` // Output only.
+}
+
+// Version ...
+type Version struct {
+	ID        string         `json:"id,omitempty"` // Output only.
+	Name      string         `json:"name,omitempty"`
+	API       string         `json:"api,omitempty"`       // Output only.
+	Schema    []string       `json:"schema,omitempty"`    // Output only.
+	CreatedBy string         `json:"createdBy,omitempty"` // Output only.
+	UpdatedBy string         `json:"updatedBy,omitempty"` // Output only.
+	CreatedAt time.Time      `json:"createdAt,omitempty"` // Output only.
+	UpdatedAt time.Time      `json:"updatedAt,omitempty"` // Output only.
+	Source    *VersionSource `json:"source,omitempty"`    // Input only.
+}
+
+// VersionSource is the version a new version is created from, along with the data copied from it.
+type VersionSource struct {
+	ID        string          `json:"id"`
+	Schema    bool            `json:"schema,omitempty"`
+	Relations SourceRelations `json:"relations,omitempty"`
+}
+
+// SourceRelations are the relations copied from a source version.
+type SourceRelations struct {
+	Documentation bool `json:"documentation,omitempty"`
+	Mock          bool `json:"mock,omitempty"`
+	Monitor       bool `json:"monitor,omitempty"`
+}
+
+// Release ...
+type Release struct {
+	ID        string    `json:"id,omitempty"` // Output only.
+	Name      string    `json:"name,omitempty"`
+	Notes     string    `json:"notes,omitempty"`
+	CreatedBy string    `json:"createdBy,omitempty"` // Output only.
+	UpdatedBy string    `json:"updatedBy,omitempty"` // Output only.
+	CreatedAt time.Time `json:"createdAt,omitempty"` // Output only.
+	UpdatedAt time.Time `json:"updatedAt,omitempty"` // Output only.
+}
+
+// Schema ...
+type Schema struct {
+	ID         string    `json:"id,omitempty"` // Output only.
+	Type       string    `json:"type,omitempty"`
+	Language   string    `json:"language,omitempty"`
+	Schema     string    `json:"schema,omitempty"`
+	APIVersion string    `json:"apiVersion,omitempty"` // Output only.
+	CreatedBy  string    `json:"createdBy,omitempty"`  // Output only.
+	UpdatedBy  string    `json:"updatedBy,omitempty"`  // Output only.
+	CreatedAt  time.Time `json:"createdAt,omitempty"`  // Output only.
+	UpdatedAt  time.Time `json:"updatedAt,omitempty"`  // Output only.
+}
+
+// CreateCollectionFromSchemaRequest is the request type for
+// POST /apis/:apiId/versions/:versionId/schemas/:schemaId/collections.
+type CreateCollectionFromSchemaRequest struct {
+	Name      string             `json:"name"`
+	Relations []RelationTypeOnly `json:"relations,omitempty"`
+}
+
+// RelationTypeOnly ...
+type RelationTypeOnly struct {
+	Type string `json:"type"`
+}
+
+// CreateCollectionFromSchemaResponse is the response type for
+// POST /apis/:apiId/versions/:versionId/schemas/:schemaId/collections.
+type CreateCollectionFromSchemaResponse struct {
+	Collection Collection        `json:"collection"`
+	Relations  []CreatedRelation `json:"relations"`
+}
+
+// Collection ...
+type Collection struct {
+	ID  string `json:"id"`
+	UID string `json:"uid"`
+}
+
+// CreatedRelation ...
+type CreatedRelation struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// CreateRelationsRequest is the request type for POST /apis/:apiId/versions/:versionId/relations.
+// Collection relations take collection uids, the other relations take the uid of their entity.
+type CreateRelationsRequest struct {
+	Documentation   []string `json:"documentation,omitempty"`
+	ContractTest    []string `json:"contracttest,omitempty"`
+	IntegrationTest []string `json:"integrationtest,omitempty"`
+	TestSuite       []string `json:"testsuite,omitempty"`
+	Monitor         []string `json:"monitor,omitempty"`
+	Mock            []string `json:"mock,omitempty"`
+	Environment     []string `json:"environment,omitempty"`
+}
+
+// CreateRelationsResponse is the response type for POST /apis/:apiId/versions/:versionId/relations.
+// It holds the ids of the created relations by type.
+type CreateRelationsResponse struct {
+	Documentation   []string `json:"documentation,omitempty"`
+	ContractTest    []string `json:"contracttest,omitempty"`
+	IntegrationTest []string `json:"integrationtest,omitempty"`
+	TestSuite       []string `json:"testsuite,omitempty"`
+	Monitor         []string `json:"monitor,omitempty"`
+	Mock            []string `json:"mock,omitempty"`
+	Environment     []string `json:"environment,omitempty"`
+}
+
+// Relations are the relations of an api version keyed by id.
+type Relations struct {
+	Documentation   map[string]Relation `json:"documentation,omitempty"`
+	ContractTest    map[string]Relation `json:"contracttest,omitempty"`
+	IntegrationTest map[string]Relation `json:"integrationtest,omitempty"`
+	TestSuite       map[string]Relation `json:"testsuite,omitempty"`
+	Monitor         map[string]Relation `json:"monitor,omitempty"`
+	Mock            map[string]Relation `json:"mock,omitempty"`
+	Environment     map[string]Relation `json:"environment,omitempty"`
+}
+
+// Relation ...
+type Relation struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Documentation is a documentation relation of an api version.
+type Documentation struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// ContractTest is a contract test relation of an api version.
+type ContractTest struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// IntegrationTest is an integration test relation of an api version.
+type IntegrationTest struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// TestSuite is a test suite relation of an api version.
+type TestSuite struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Test is a test relation of an api version.
+type Test struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Monitor is a monitor relation of an api version.
+type Monitor struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	MonitorID string    `json:"monitorId,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Environment is an environment relation of an api version.
+type Environment struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+type apiWrapper struct {
+	API  API   `json:"api,omitempty"`
+	APIs []API `json:"apis,omitempty"`
+}
+
+type versionWrapper struct {
+	Version  Version   `json:"version,omitempty"`
+	Versions []Version `json:"versions,omitempty"`
+}
+
+type releaseWrapper struct {
+	Release  Release   `json:"release,omitempty"`
+	Releases []Release `json:"releases,omitempty"`
+}
+
+type schemaWrapper struct {
+	Schema Schema `json:"schema"`
+}
+
+type relationsWrapper struct {
+	Relations Relations `json:"relations"`
+}
@@ -0,0 +1,79 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Position is the position of Tail in the audit log.
+type Position struct {
+	// Since is the lower bound of the trails to send.
+	Since time.Time `json:"since"`
+	// Seen holds the ids of the trails already sent on or after Since.
+	Seen []int `json:"seen,omitempty"`
+}
+
+// Checkpoint persists the position of Tail so it can resume after a restart.
+type Checkpoint interface {
+	// Load returns the saved position, or the zero Position when there is none.
+	Load(ctx context.Context) (Position, error)
+	// Save persists the position.
+	Save(ctx context.Context, pos Position) error
+}
+
+// FileCheckpoint is a Checkpoint storing the position as JSON in a file.
+type FileCheckpoint struct {
+	path string
+}
+
+// NewFileCheckpoint returns a new instance of FileCheckpoint storing the position at path.
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{
+		path: path,
+	}
+}
+
+// Load satisfies the Checkpoint interface.
+func (f *FileCheckpoint) Load(_ context.Context) (Position, error) {
+	b, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Position{}, nil
+	}
+	if err != nil {
+		return Position{}, err
+	}
+
+	var pos Position
+	err = json.Unmarshal(b, &pos)
+
+	return pos, err
+}
+
+// Save satisfies the Checkpoint interface. The file is replaced atomically.
+func (f *FileCheckpoint) Save(_ context.Context, pos Position) error {
+	b, err := json.Marshal(pos)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path)
+}
@@ -12,7 +12,7 @@ import (
 
 const path = "/audit/logs"
 
-// Client handles webhook operations.
+// Client handles audit logs operations.
 type Client struct {
 	restClient *rest.Client
 }
@@ -25,7 +25,39 @@ func NewClient(restClient *rest.Client) *Client {
 }
 
 // Get sends a GET request to /audit/logs.
-func (c *Client) Get(ctx context.Context, req GetAuditLogsRequest) (AuditLogs, error) {
+func (c *Client) Get(
+	ctx context.Context,
+	req GetAuditLogsRequest,
+	opts ...rest.RequestOption,
+) (AuditLogs, error) {
+	var cursor Cursor
+	if req.Cursor != nil {
+		cursor = Cursor(strconv.Itoa(*req.Cursor))
+	}
+
+	logs, err := c.get(ctx, req, cursor, opts...)
+	if err != nil || len(req.Filters) == 0 {
+		return logs, err
+	}
+
+	trails := logs.Trails[:0]
+	for _, t := range logs.Trails {
+		if match(t, req.Filters) {
+			trails = append(trails, t)
+		}
+	}
+	logs.Trails = trails
+
+	return logs, nil
+}
+
+// get sends a GET request to /audit/logs for the page at cursor, ignoring req.Cursor and req.Filters.
+func (c *Client) get(
+	ctx context.Context,
+	req GetAuditLogsRequest,
+	cursor Cursor,
+	opts ...rest.RequestOption,
+) (AuditLogs, error) {
 	r, err := c.restClient.NewRequest(
 		ctx,
 		http.MethodGet,
@@ -45,8 +77,8 @@ func (c *Client) Get(ctx context.Context, req GetAuditLogsRequest) (AuditLogs, e
 	if req.Limit != nil {
 		q.Add("limit", strconv.Itoa(*req.Limit))
 	}
-	if req.Cursor != nil {
-		q.Add("cursor", strconv.Itoa(*req.Cursor))
+	if cursor != "" {
+		q.Add("cursor", string(cursor))
 	}
 	if req.OrderBy != nil {
 		q.Add("order_by", *req.OrderBy)
@@ -54,7 +86,7 @@ func (c *Client) Get(ctx context.Context, req GetAuditLogsRequest) (AuditLogs, e
 	r.URL.RawQuery = q.Encode()
 
 	var response AuditLogs
-	err = c.restClient.DoRequest(r, &response)
+	err = c.restClient.DoRequest(r, &response, opts...)
 
 	return response, err
 }
@@ -0,0 +1,153 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/auditlogs"
+)
+
+const (
+	cefVendor  = "Postman"
+	cefProduct = "Postman API"
+	cefVersion = "1.0"
+
+	// syslogPriority is the facility log audit (13) with the informational severity (6).
+	syslogPriority = 13*8 + 6
+)
+
+// CEFWriter writes trails as CEF events wrapped in RFC 5424 syslog messages, one per line.
+type CEFWriter struct {
+	w        io.Writer
+	hostname string
+	appName  string
+	severity func(auditlogs.Trail) int
+}
+
+// NewCEFWriter returns a new instance of CEFWriter writing to w, e.g. a connection from DialSyslog.
+func NewCEFWriter(w io.Writer, opts ...CEFOption) *CEFWriter {
+	hostname, _ := os.Hostname()
+	options := cefOptions{
+		hostname: hostname,
+		appName:  "postman-audit",
+		severity: func(auditlogs.Trail) int { return 3 },
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	return &CEFWriter{
+		w:        w,
+		hostname: options.hostname,
+		appName:  options.appName,
+		severity: options.severity,
+	}
+}
+
+// WriteTrail satisfies the Writer interface. Each trail is written with a single call to
+// the underlying writer, so every syslog message is sent as one datagram over udp.
+func (c *CEFWriter) WriteTrail(t auditlogs.Trail) error {
+	_, err := io.WriteString(c.w, c.format(t)+"\n")
+	return err
+}
+
+// Flush satisfies the Writer interface. CEFWriter doesn't buffer.
+func (c *CEFWriter) Flush() error {
+	return nil
+}
+
+func (c *CEFWriter) format(t auditlogs.Trail) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>1 %s %s %s - - - ",
+		syslogPriority,
+		t.Timestamp.UTC().Format(time.RFC3339Nano),
+		syslogField(c.hostname),
+		syslogField(c.appName),
+	)
+
+	name := t.Message
+	if name == "" {
+		name = string(t.Action)
+	}
+	fmt.Fprintf(&b, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeader(cefVendor),
+		cefHeader(cefProduct),
+		cefHeader(cefVersion),
+		cefHeader(string(t.Action)),
+		cefHeader(name),
+		c.severity(t),
+	)
+
+	ext := []struct {
+		key   string
+		label string
+		value string
+	}{
+		{key: "rt", value: strconv.FormatInt(t.Timestamp.UnixMilli(), 10)},
+		{key: "externalId", value: strconv.Itoa(t.ID)},
+		{key: "act", value: string(t.Action)},
+		{key: "msg", value: t.Message},
+		{key: "src", value: t.IP},
+		{key: "requestClientApplication", value: t.UserAgent},
+		{key: "suid", value: idOrEmpty(t.Data.Actor.ID)},
+		{key: "suser", value: t.Data.Actor.Username},
+		{key: "sntdom", value: t.Data.Team.Name},
+		{key: "duid", value: idOrEmpty(t.Data.User.ID)},
+		{key: "duser", value: t.Data.User.Username},
+		{key: "cs1", label: "actorEmail", value: t.Data.Actor.Email},
+		{key: "cs2", label: "userEmail", value: t.Data.User.Email},
+		{key: "cn1", label: "teamId", value: idOrEmpty(t.Data.Team.ID)},
+	}
+	sep := ""
+	for _, e := range ext {
+		if e.value == "" {
+			continue
+		}
+		if e.label != "" {
+			fmt.Fprintf(&b, "%s%sLabel=%s", sep, e.key, cefExtension(e.label))
+			sep = " "
+		}
+		fmt.Fprintf(&b, "%s%s=%s", sep, e.key, cefExtension(e.value))
+		sep = " "
+	}
+
+	return b.String()
+}
+
+func idOrEmpty(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// cefHeader escapes a CEF header field.
+func cefHeader(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ").Replace(s)
+}
+
+// cefExtension escapes a CEF extension value.
+func cefExtension(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`).Replace(s)
+}
+
+// syslogField returns s as a syslog header field, which is printable ascii without spaces or "-" when empty.
+func syslogField(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return -1
+		}
+		return r
+	}, s)
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,82 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/actatum/postman-client/auditlogs"
+)
+
+// CSVHeader is the header row written by CSVWriter, with the nested trail data flattened.
+var CSVHeader = []string{
+	"id",
+	"timestamp",
+	"action",
+	"message",
+	"ip",
+	"userAgent",
+	"actor.id",
+	"actor.name",
+	"actor.username",
+	"actor.email",
+	"actor.active",
+	"user.id",
+	"user.name",
+	"user.username",
+	"user.email",
+	"team.id",
+	"team.name",
+}
+
+// CSVWriter writes trails as flattened CSV records, preceded by CSVHeader.
+type CSVWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+// NewCSVWriter returns a new instance of CSVWriter writing to w.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{
+		w: csv.NewWriter(w),
+	}
+}
+
+// WriteTrail satisfies the Writer interface.
+func (c *CSVWriter) WriteTrail(t auditlogs.Trail) error {
+	if !c.wroteHeader {
+		if err := c.w.Write(CSVHeader); err != nil {
+			return err
+		}
+		c.wroteHeader = true
+	}
+
+	return c.w.Write([]string{
+		strconv.Itoa(t.ID),
+		t.Timestamp.UTC().Format(time.RFC3339),
+		string(t.Action),
+		t.Message,
+		t.IP,
+		t.UserAgent,
+		strconv.Itoa(t.Data.Actor.ID),
+		t.Data.Actor.Name,
+		t.Data.Actor.Username,
+		t.Data.Actor.Email,
+		strconv.FormatBool(t.Data.Actor.Active),
+		strconv.Itoa(t.Data.User.ID),
+		t.Data.User.Name,
+		t.Data.User.Username,
+		t.Data.User.Email,
+		strconv.Itoa(t.Data.Team.ID),
+		t.Data.Team.Name,
+	})
+}
+
+// Flush satisfies the Writer interface.
+func (c *CSVWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,69 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+//
+// Export a paginated fetch:
+//
+//	w := export.NewCSVWriter(f)
+//	n, err := export.Copy(w, cs.AuditLogs().Iter(ctx, req))
+//
+// Or forward a tailing stream to a syslog server:
+//
+//	conn, err := export.DialSyslog("udp", "siem.internal:514")
+//	trails, err := cs.AuditLogs().Tail(ctx)
+//	n, err := export.Stream(ctx, export.NewCEFWriter(conn), trails)
+package export
+
+import (
+	"context"
+
+	"github.com/actatum/postman-client/auditlogs"
+)
+
+// Writer writes trails in an export format.
+type Writer interface {
+	// WriteTrail writes a single trail.
+	WriteTrail(t auditlogs.Trail) error
+	// Flush writes any buffered data to the underlying io.Writer.
+	Flush() error
+}
+
+// Copy writes the trails of the iterator to w until it is exhausted, then flushes w.
+// It returns the number of trails written.
+func Copy(w Writer, it *auditlogs.Iterator) (int, error) {
+	n := 0
+	for it.Next() {
+		if err := w.WriteTrail(it.Trail()); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		_ = w.Flush()
+		return n, err
+	}
+
+	return n, w.Flush()
+}
+
+// Stream writes the trails received from the channel to w, flushing after each one,
+// until the channel is closed or ctx is done. It returns the number of trails written.
+func Stream(ctx context.Context, w Writer, trails <-chan auditlogs.Trail) (int, error) {
+	n := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return n, ctx.Err()
+		case t, ok := <-trails:
+			if !ok {
+				return n, nil
+			}
+			if err := w.WriteTrail(t); err != nil {
+				return n, err
+			}
+			if err := w.Flush(); err != nil {
+				return n, err
+			}
+			n++
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+package export
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/actatum/postman-client/auditlogs"
+)
+
+var testTrail = auditlogs.Trail{
+	ID:        12345678,
+	IP:        "192.0.2.1",
+	UserAgent: "PostmanRuntime/7.29.2",
+	Action:    "user.login_google_success",
+	Timestamp: time.Date(2022, 8, 31, 15, 19, 32, 0, time.UTC),
+	Message:   "Taylor Lee logged in using the Google OAuth.",
+	Data: auditlogs.TrailData{
+		Actor: auditlogs.Actor{Name: "Taylor Lee", Username: "taylor-lee", Email: "taylor.lee@example.com", ID: 12345678},
+		User:  auditlogs.User{Name: "Taylor Lee", Username: "taylor-lee", Email: "taylor.lee@example.com", ID: 12345678},
+		Team:  auditlogs.Team{Name: "Test Team", ID: 1234},
+	},
+}
+
+func TestWriters(t *testing.T) {
+	tests := []struct {
+		name      string
+		newWriter func(b *bytes.Buffer) Writer
+		want      string
+	}{
+		{
+			name:      "jsonl",
+			newWriter: func(b *bytes.Buffer) Writer { return NewJSONLWriter(b) },
+			want: `{"id":12345678,"ip":"192.0.2.1","userAgent":"PostmanRuntime/7.29.2",` +
+				`"action":"user.login_google_success","timestamp":"2022-08-31T15:19:32Z",` +
+				`"message":"Taylor Lee logged in using the Google OAuth.","data":{"actor":{"name":"Taylor Lee",` +
+				`"username":"taylor-lee","email":"taylor.lee@example.com","id":12345678,"active":false},` +
+				`"user":{"name":"Taylor Lee","username":"taylor-lee","email":"taylor.lee@example.com","id":12345678},` +
+				`"team":{"name":"Test Team","id":1234}}}` + "\n",
+		},
+		{
+			name:      "csv",
+			newWriter: func(b *bytes.Buffer) Writer { return NewCSVWriter(b) },
+			want: strings.Join(CSVHeader, ",") + "\n" +
+				"12345678,2022-08-31T15:19:32Z,user.login_google_success," +
+				"Taylor Lee logged in using the Google OAuth.,192.0.2.1,PostmanRuntime/7.29.2," +
+				"12345678,Taylor Lee,taylor-lee,taylor.lee@example.com,false," +
+				"12345678,Taylor Lee,taylor-lee,taylor.lee@example.com,1234,Test Team\n",
+		},
+		{
+			name: "cef",
+			newWriter: func(b *bytes.Buffer) Writer {
+				return NewCEFWriter(b, WithHostname("collector"), WithSeverity(func(t auditlogs.Trail) int {
+					if t.Action.Category() == auditlogs.CategoryAuthentication {
+						return 5
+					}
+					return 3
+				}))
+			},
+			want: "<110>1 2022-08-31T15:19:32Z collector postman-audit - - - " +
+				"CEF:0|Postman|Postman API|1.0|user.login_google_success|" +
+				"Taylor Lee logged in using the Google OAuth.|5|" +
+				"rt=1661959172000 externalId=12345678 act=user.login_google_success " +
+				"msg=Taylor Lee logged in using the Google OAuth. src=192.0.2.1 " +
+				"requestClientApplication=PostmanRuntime/7.29.2 suid=12345678 suser=taylor-lee sntdom=Test Team " +
+				"duid=12345678 duser=taylor-lee cs1Label=actorEmail cs1=taylor.lee@example.com " +
+				"cs2Label=userEmail cs2=taylor.lee@example.com cn1Label=teamId cn1=1234\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			w := tt.newWriter(&b)
+			if err := w.WriteTrail(testTrail); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("WriteTrail() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCEFEscaping(t *testing.T) {
+	trail := auditlogs.Trail{
+		Action:  "team.rename",
+		Message: "renamed a|b to c=d\\e\nf",
+	}
+
+	var b bytes.Buffer
+	if err := NewCEFWriter(&b, WithHostname("")).WriteTrail(trail); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<110>1 0001-01-01T00:00:00Z - postman-audit - - - ` +
+		`CEF:0|Postman|Postman API|1.0|team.rename|renamed a\|b to c=d\\e f|3|` +
+		`rt=-62135596800000 externalId=0 act=team.rename msg=renamed a|b to c\=d\\e\nf` + "\n"
+	if got := b.String(); got != want {
+		t.Errorf("WriteTrail() got = %v, want %v", got, want)
+	}
+}
+
+func TestStream(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	syslog, err := DialSyslog("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = syslog.Close() })
+
+	trails := make(chan auditlogs.Trail, 2)
+	trails <- testTrail
+	trails <- auditlogs.Trail{ID: 2, Action: "user.logout"}
+	close(trails)
+
+	n, err := Stream(context.Background(), NewCEFWriter(syslog), trails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Stream() got = %v, want %v", n, 2)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 2048)
+	for _, want := range []string{"externalId=12345678", "externalId=2"} {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg := string(buf[:n]); !strings.Contains(msg, want) || strings.Count(msg, "\n") != 1 {
+			t.Errorf("ReadFrom() got = %v, want one message with %v", msg, want)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/actatum/postman-client/auditlogs"
+)
+
+// JSONLWriter writes trails as JSON Lines, one json object per line in the api format.
+type JSONLWriter struct {
+	enc *json.Encoder
+}
+
+// NewJSONLWriter returns a new instance of JSONLWriter writing to w.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// WriteTrail satisfies the Writer interface.
+func (j *JSONLWriter) WriteTrail(t auditlogs.Trail) error {
+	return j.enc.Encode(t)
+}
+
+// Flush satisfies the Writer interface. JSONLWriter doesn't buffer.
+func (j *JSONLWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,51 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+package export
+
+import "github.com/actatum/postman-client/auditlogs"
+
+type cefOptions struct {
+	hostname string
+	appName  string
+	severity func(auditlogs.Trail) int
+}
+
+// CEFOption represents functional options for configuring the CEFWriter.
+type CEFOption interface {
+	apply(*cefOptions)
+}
+
+type hostnameOption string
+
+func (h hostnameOption) apply(opts *cefOptions) {
+	opts.hostname = string(h)
+}
+
+// WithHostname configures the hostname of the syslog header. Defaults to os.Hostname.
+func WithHostname(h string) CEFOption {
+	return hostnameOption(h)
+}
+
+type appNameOption string
+
+func (a appNameOption) apply(opts *cefOptions) {
+	opts.appName = string(a)
+}
+
+// WithAppName configures the app name of the syslog header. Defaults to "postman-audit".
+func WithAppName(a string) CEFOption {
+	return appNameOption(a)
+}
+
+type severityOption func(auditlogs.Trail) int
+
+func (s severityOption) apply(opts *cefOptions) {
+	if s != nil {
+		opts.severity = s
+	}
+}
+
+// WithSeverity configures the CEF severity (0 to 10) of each trail. Defaults to 3 for every trail.
+func WithSeverity(fn func(auditlogs.Trail) int) CEFOption {
+	return severityOption(fn)
+}
@@ -0,0 +1,14 @@
+// Package export provides writers exporting audit log trails as JSON Lines, CSV or CEF syslog messages,
+// e.g. to forward them to a SIEM.
+package export
+
+import (
+	"net"
+	"time"
+)
+
+// DialSyslog connects to the syslog server at addr over the given network ("udp" or "tcp").
+// Use the connection as the io.Writer of a CEFWriter; messages are newline delimited over tcp.
+func DialSyslog(network, addr string) (net.Conn, error) {
+	return net.DialTimeout(network, addr, 10*time.Second)
+}
@@ -0,0 +1,115 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// Filter reports whether a trail should be kept. Filters are applied client side,
+// as /audit/logs only filters by time.
+type Filter func(t Trail) bool
+
+// ByAction keeps the trails with one of the given actions.
+func ByAction(actions ...Action) Filter {
+	set := make(map[Action]struct{}, len(actions))
+	for _, a := range actions {
+		set[a] = struct{}{}
+	}
+	return func(t Trail) bool {
+		_, ok := set[t.Action]
+		return ok
+	}
+}
+
+// ByCategory keeps the trails whose action is in one of the given categories.
+func ByCategory(categories ...Category) Filter {
+	set := make(map[Category]struct{}, len(categories))
+	for _, c := range categories {
+		set[c] = struct{}{}
+	}
+	return func(t Trail) bool {
+		_, ok := set[t.Action.Category()]
+		return ok
+	}
+}
+
+// ByActorEmail keeps the trails whose actor has one of the given emails, ignoring case.
+func ByActorEmail(emails ...string) Filter {
+	return func(t Trail) bool {
+		for _, e := range emails {
+			if strings.EqualFold(t.Data.Actor.Email, e) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByActorID keeps the trails whose actor has one of the given ids.
+func ByActorID(ids ...int) Filter {
+	return func(t Trail) bool {
+		for _, id := range ids {
+			if t.Data.Actor.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByIP keeps the trails whose ip is in one of the given CIDR ranges, e.g. "10.0.0.0/8".
+// Trails with a missing or invalid ip are dropped.
+func ByIP(cidrs ...string) (Filter, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, c := range cidrs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+
+	return func(t Trail) bool {
+		addr, err := netip.ParseAddr(t.IP)
+		if err != nil {
+			return false
+		}
+		addr = addr.Unmap()
+		for _, p := range prefixes {
+			if p.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// Not keeps the trails dropped by f.
+func Not(f Filter) Filter {
+	return func(t Trail) bool {
+		return !f(t)
+	}
+}
+
+// Any keeps the trails matching at least one of the filters.
+func Any(filters ...Filter) Filter {
+	return func(t Trail) bool {
+		for _, f := range filters {
+			if f(t) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// match reports whether the trail matches all the filters.
+func match(t Trail, filters []Filter) bool {
+	for _, f := range filters {
+		if !f(t) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+func TestAction_Category(t *testing.T) {
+	tests := []struct {
+		action Action
+		want   Category
+	}{
+		{action: ActionUserLoginGoogleSuccess, want: CategoryAuthentication},
+		{action: "user.login_github_success", want: CategoryAuthentication},
+		{action: ActionAPIKeyCreated, want: CategoryAPIKey},
+		{action: ActionWorkspaceDeleted, want: CategoryWorkspace},
+		{action: ActionRoleChanged, want: CategoryRole},
+		{action: "collection.deleted", want: "collection"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			if got := tt.action.Category(); got != tt.want {
+				t.Errorf("Category() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilters(t *testing.T) {
+	trails := []Trail{
+		{ID: 1, Action: ActionUserLogin, IP: "10.1.2.3", Data: TrailData{Actor: Actor{ID: 7, Email: "Ops@example.com"}}},
+		{ID: 2, Action: ActionAPIKeyCreated, IP: "192.0.2.10", Data: TrailData{Actor: Actor{ID: 8}}},
+		{ID: 3, Action: ActionWorkspaceDeleted, IP: "::ffff:10.0.0.1", Data: TrailData{Actor: Actor{ID: 7}}},
+		{ID: 4, Action: ActionRoleChanged, IP: "2001:db8::1", Data: TrailData{Actor: Actor{ID: 9}}},
+	}
+
+	private, err := ByIP("10.0.0.0/8", "2001:db8::/32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ByIP("10.0.0.0"); err == nil {
+		t.Error("ByIP() error got = nil, want invalid CIDR error")
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []int
+	}{
+		{name: "action", filter: ByAction(ActionAPIKeyCreated, ActionRoleChanged), want: []int{2, 4}},
+		{name: "category", filter: ByCategory(CategoryAuthentication, CategoryWorkspace), want: []int{1, 3}},
+		{name: "actor email", filter: ByActorEmail("ops@example.com"), want: []int{1}},
+		{name: "actor id", filter: ByActorID(7), want: []int{1, 3}},
+		{name: "ip", filter: private, want: []int{1, 3, 4}},
+		{name: "not", filter: Not(private), want: []int{2}},
+		{name: "any", filter: Any(ByActorID(9), ByAction(ActionUserLogin)), want: []int{1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, trail := range trails {
+				if tt.filter(trail) {
+					got = append(got, trail.ID)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Filters(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = w.Write([]byte(`{"trails":[{"id":1,"action":"user.login"},{"id":2,"action":"api_key.created"}],
+				"meta":{"nextCursor":2}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"trails":[{"id":3,"action":"api_key.revoked"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	req := GetAuditLogsRequest{Filters: []Filter{ByCategory(CategoryAPIKey)}}
+
+	logs, err := c.Get(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs.Trails) != 1 || logs.Trails[0].ID != 2 || logs.Meta.NextCursor != "2" {
+		t.Errorf("Get() got = %+v, want trail 2 and next cursor", logs)
+	}
+
+	var ids []int
+	it := c.Iter(context.Background(), req)
+	for it.Next() {
+		ids = append(ids, it.Trail().ID)
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	if !reflect.DeepEqual(ids, []int{2, 3}) {
+		t.Errorf("Iter() got = %v, want %v", ids, []int{2, 3})
+	}
+}
@@ -0,0 +1,155 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+// dateLayout is the layout of the Since and Until request parameters.
+const dateLayout = "2006-01-02"
+
+// Iterator lazily walks the pages of /audit/logs, one trail at a time.
+//
+//	it := client.Iter(ctx, req)
+//	for it.Next() {
+//		trail := it.Trail()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator struct {
+	client *Client
+	ctx    context.Context
+	req    GetAuditLogsRequest
+	opts   []rest.RequestOption
+
+	since     time.Time
+	until     time.Time
+	ascending bool
+
+	cursor  Cursor
+	fetched bool
+	page    []Trail
+	trail   Trail
+	done    bool
+	err     error
+}
+
+// Iter returns an Iterator over the trails matching req, starting at req.Cursor.
+// Pages of req.Limit trails are fetched as the iterator advances, until the last page
+// or the first trail outside of req.Since and req.Until. Trails not matching req.Filters are skipped.
+func (c *Client) Iter(
+	ctx context.Context,
+	req GetAuditLogsRequest,
+	opts ...rest.RequestOption,
+) *Iterator {
+	it := &Iterator{
+		client:    c,
+		ctx:       ctx,
+		req:       req,
+		opts:      opts,
+		ascending: req.OrderBy != nil && strings.EqualFold(*req.OrderBy, "ASC"),
+	}
+	if req.Cursor != nil {
+		it.cursor = Cursor(strconv.Itoa(*req.Cursor))
+	}
+	if req.Since != nil {
+		it.since, _ = time.Parse(dateLayout, *req.Since)
+	}
+	if req.Until != nil {
+		if until, err := time.Parse(dateLayout, *req.Until); err == nil {
+			// until is inclusive of the whole day.
+			it.until = until.AddDate(0, 0, 1)
+		}
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next trail, fetching the next page when needed.
+// It returns false when there are no more trails or an error occurred.
+func (it *Iterator) Next() bool {
+	for !it.done {
+		if len(it.page) == 0 {
+			if !it.fetch() {
+				return false
+			}
+			continue
+		}
+
+		t := it.page[0]
+		it.page = it.page[1:]
+
+		switch {
+		case it.before(t):
+			if !it.ascending {
+				it.done = true
+				return false
+			}
+		case it.after(t):
+			if it.ascending {
+				it.done = true
+				return false
+			}
+		case match(t, it.req.Filters):
+			it.trail = t
+			return true
+		}
+	}
+
+	return false
+}
+
+// Trail returns the current trail.
+func (it *Iterator) Trail() Trail {
+	return it.trail
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// fetch gets the next page, reporting whether there is one.
+func (it *Iterator) fetch() bool {
+	if it.fetched && it.cursor == "" {
+		it.done = true
+		return false
+	}
+
+	logs, err := it.client.get(it.ctx, it.req, it.cursor, it.opts...)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	// Guard against the api returning the same cursor forever.
+	if it.fetched && logs.Meta.NextCursor == it.cursor {
+		logs.Meta.NextCursor = ""
+	}
+	it.fetched = true
+	it.cursor = logs.Meta.NextCursor
+	it.page = logs.Trails
+
+	if len(it.page) == 0 {
+		it.done = true
+		return false
+	}
+
+	return true
+}
+
+func (it *Iterator) before(t Trail) bool {
+	return !it.since.IsZero() && t.Timestamp.Before(it.since)
+}
+
+func (it *Iterator) after(t Trail) bool {
+	return !it.until.IsZero() && !t.Timestamp.Before(it.until)
+}
@@ -0,0 +1,120 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+func TestCursor_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Cursor
+		wantErr bool
+	}{
+		{name: "number", data: `{"nextCursor":300}`, want: "300"},
+		{name: "string", data: `{"nextCursor":"b2Zmc2V0PTMwMA=="}`, want: "b2Zmc2V0PTMwMA=="},
+		{name: "null", data: `{"nextCursor":null}`, want: ""},
+		{name: "missing", data: `{}`, want: ""},
+		{name: "invalid", data: `{"nextCursor":true}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Meta
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.NextCursor != tt.want {
+				t.Errorf("json.Unmarshal() got = %v, want %v", got.NextCursor, tt.want)
+			}
+		})
+	}
+}
+
+func TestIterator(t *testing.T) {
+	pages := map[string]string{
+		"": `{"trails":[{"id":5,"timestamp":"2022-10-05T12:00:00Z"},{"id":4,"timestamp":"2022-10-04T12:00:00Z"}],
+			"meta":{"nextCursor":"page-2"}}`,
+		"page-2": `{"trails":[{"id":3,"timestamp":"2022-10-03T12:00:00Z"},{"id":2,"timestamp":"2022-10-02T12:00:00Z"}],
+			"meta":{"nextCursor":4}}`,
+		"4": `{"trails":[{"id":1,"timestamp":"2022-10-01T12:00:00Z"}]}`,
+	}
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		requests = append(requests, q.Encode())
+		body, ok := pages[q.Get("cursor")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+
+	collect := func(it *Iterator) []int {
+		var ids []int
+		for it.Next() {
+			ids = append(ids, it.Trail().ID)
+		}
+		return ids
+	}
+
+	t.Run("all pages", func(t *testing.T) {
+		requests = nil
+		limit := 2
+		it := c.Iter(context.Background(), GetAuditLogsRequest{Limit: &limit})
+		got := collect(it)
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		if want := []int{5, 4, 3, 2, 1}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Iter() got = %v, want %v", got, want)
+		}
+		want := []string{"limit=2", "cursor=page-2&limit=2", "cursor=4&limit=2"}
+		if !reflect.DeepEqual(requests, want) {
+			t.Errorf("Iter() requests got = %v, want %v", requests, want)
+		}
+	})
+
+	t.Run("stops at since", func(t *testing.T) {
+		requests = nil
+		since, until := "2022-10-03", "2022-10-04"
+		it := c.Iter(context.Background(), GetAuditLogsRequest{Since: &since, Until: &until})
+		got := collect(it)
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		if want := []int{4, 3}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Iter() got = %v, want %v", got, want)
+		}
+		if len(requests) != 2 {
+			t.Errorf("Iter() requests got = %v, want 2 pages", requests)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cursor := 99
+		it := c.Iter(context.Background(), GetAuditLogsRequest{Cursor: &cursor})
+		if it.Next() {
+			t.Fatal("Next() got = true, want false")
+		}
+		var e *rest.Error
+		if !errors.As(it.Err(), &e) || e.StatusCode != http.StatusBadRequest {
+			t.Errorf("Err() got = %v, want a %d error", it.Err(), http.StatusBadRequest)
+		}
+	})
+}
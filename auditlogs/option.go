@@ -0,0 +1,114 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import "time"
+
+type tailOptions struct {
+	pollInterval time.Duration
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	pageSize     int
+	since        time.Time
+	checkpoint   Checkpoint
+	onError      func(error)
+	filters      []Filter
+}
+
+// TailOption represents functional options for configuring Tail.
+type TailOption interface {
+	apply(*tailOptions)
+}
+
+type pollIntervalOption time.Duration
+
+func (p pollIntervalOption) apply(opts *tailOptions) {
+	if p > 0 {
+		opts.pollInterval = time.Duration(p)
+	}
+}
+
+// WithPollInterval configures the time between two polls of /audit/logs. Defaults to 30 seconds.
+func WithPollInterval(d time.Duration) TailOption {
+	return pollIntervalOption(d)
+}
+
+type backoffOption struct {
+	min time.Duration
+	max time.Duration
+}
+
+func (b backoffOption) apply(opts *tailOptions) {
+	if b.min > 0 {
+		opts.minBackoff = b.min
+	}
+	if b.max >= opts.minBackoff {
+		opts.maxBackoff = b.max
+	}
+}
+
+// WithBackoff configures the wait after a failed poll, doubling from minBackoff up to maxBackoff
+// on consecutive failures. Defaults to 1 second and 5 minutes.
+func WithBackoff(minBackoff, maxBackoff time.Duration) TailOption {
+	return backoffOption{min: minBackoff, max: maxBackoff}
+}
+
+type pageSizeOption int
+
+func (p pageSizeOption) apply(opts *tailOptions) {
+	if p > 0 {
+		opts.pageSize = int(p)
+	}
+}
+
+// WithPageSize configures the number of trails fetched per request. Defaults to the maximum of 300.
+func WithPageSize(n int) TailOption {
+	return pageSizeOption(n)
+}
+
+type sinceOption time.Time
+
+func (s sinceOption) apply(opts *tailOptions) {
+	opts.since = time.Time(s)
+}
+
+// WithSince configures the time to start tailing from when the checkpoint holds no position.
+func WithSince(t time.Time) TailOption {
+	return sinceOption(t)
+}
+
+type checkpointOption struct {
+	c Checkpoint
+}
+
+func (c checkpointOption) apply(opts *tailOptions) {
+	opts.checkpoint = c.c
+}
+
+// WithCheckpoint configures where Tail loads its starting position from and saves its progress to.
+func WithCheckpoint(c Checkpoint) TailOption {
+	return checkpointOption{c: c}
+}
+
+type errorHandlerOption func(error)
+
+func (e errorHandlerOption) apply(opts *tailOptions) {
+	if e != nil {
+		opts.onError = e
+	}
+}
+
+// WithErrorHandler configures a callback for the errors of failed polls, e.g. for logging.
+func WithErrorHandler(fn func(error)) TailOption {
+	return errorHandlerOption(fn)
+}
+
+type filterOption []Filter
+
+func (f filterOption) apply(opts *tailOptions) {
+	opts.filters = append(opts.filters, f...)
+}
+
+// WithFilters configures Tail to only send the trails matching all the filters.
+func WithFilters(filters ...Filter) TailOption {
+	return filterOption(filters)
+}
@@ -0,0 +1,187 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Tail polls /audit/logs and sends new trails to the returned channel, oldest first, until ctx is done.
+// The channel is closed when tailing stops.
+//
+// Without a checkpoint or WithSince, tailing starts at the time of the call. Trails are deduplicated by ID,
+// and the position of the last trail received from the channel is saved to the checkpoint after each poll,
+// so a restarted process resumes where the previous one stopped.
+// Failed polls are retried with an exponential backoff and reported to the WithErrorHandler callback.
+func (c *Client) Tail(ctx context.Context, opts ...TailOption) (<-chan Trail, error) {
+	options := tailOptions{
+		pollInterval: 30 * time.Second,
+		minBackoff:   time.Second,
+		maxBackoff:   5 * time.Minute,
+		pageSize:     300,
+		onError:      func(error) {},
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	pos := Position{Since: options.since}
+	if options.checkpoint != nil {
+		saved, err := options.checkpoint.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !saved.Since.IsZero() {
+			pos = saved
+		}
+	}
+	if pos.Since.IsZero() {
+		pos.Since = time.Now()
+	}
+
+	t := &tailer{
+		client:  c,
+		options: options,
+		since:   pos.Since,
+		seen:    make(map[int]time.Time, len(pos.Seen)),
+	}
+	for _, id := range pos.Seen {
+		t.seen[id] = pos.Since
+	}
+
+	trails := make(chan Trail)
+	go t.run(ctx, trails)
+
+	return trails, nil
+}
+
+// tailer holds the state of a Tail call.
+type tailer struct {
+	client  *Client
+	options tailOptions
+
+	// since is the lower bound of the trails to send.
+	since time.Time
+	// seen holds the timestamps of the trails sent on or after since.
+	seen map[int]time.Time
+}
+
+func (t *tailer) run(ctx context.Context, trails chan<- Trail) {
+	defer close(trails)
+
+	var backoff time.Duration
+	for {
+		wait := t.options.pollInterval
+		if err := t.poll(ctx, trails); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			t.options.onError(err)
+
+			backoff = t.nextBackoff(backoff)
+			wait = backoff
+		} else {
+			backoff = 0
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// poll sends the trails which haven't been seen yet and saves the new position.
+func (t *tailer) poll(ctx context.Context, trails chan<- Trail) (err error) {
+	since, limit, order := t.since.UTC().Format(dateLayout), t.options.pageSize, "ASC"
+	it := t.client.Iter(ctx, GetAuditLogsRequest{
+		Since:   &since,
+		Limit:   &limit,
+		OrderBy: &order,
+	})
+
+	updated := false
+	defer func() {
+		if !updated || t.options.checkpoint == nil {
+			return
+		}
+		// Save the trails sent so far even when ctx is done.
+		if saveErr := t.options.checkpoint.Save(context.WithoutCancel(ctx), t.position()); err == nil {
+			err = saveErr
+		}
+	}()
+
+	for it.Next() {
+		trail := it.Trail()
+		if trail.Timestamp.Before(t.since) {
+			continue
+		}
+		if _, ok := t.seen[trail.ID]; ok {
+			continue
+		}
+
+		if match(trail, t.options.filters) {
+			select {
+			case trails <- trail:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		t.seen[trail.ID] = trail.Timestamp
+		updated = true
+	}
+	if err = it.Err(); err != nil {
+		return err
+	}
+
+	t.advance()
+	return nil
+}
+
+// advance moves since to the start of the day of the latest trail sent, as the api filters by day,
+// and forgets the trails sent before it.
+func (t *tailer) advance() {
+	var latest time.Time
+	for _, ts := range t.seen {
+		if ts.After(latest) {
+			latest = ts
+		}
+	}
+
+	day := latest.UTC().Truncate(24 * time.Hour)
+	if !day.After(t.since) {
+		return
+	}
+
+	t.since = day
+	for id, ts := range t.seen {
+		if ts.Before(day) {
+			delete(t.seen, id)
+		}
+	}
+}
+
+func (t *tailer) position() Position {
+	pos := Position{Since: t.since, Seen: make([]int, 0, len(t.seen))}
+	for id := range t.seen {
+		pos.Seen = append(pos.Seen, id)
+	}
+	sort.Ints(pos.Seen)
+	return pos
+}
+
+func (t *tailer) nextBackoff(prev time.Duration) time.Duration {
+	if prev == 0 {
+		return t.options.minBackoff
+	}
+	next := prev * 2
+	if next > t.options.maxBackoff {
+		next = t.options.maxBackoff
+	}
+	return next
+}
@@ -0,0 +1,181 @@
+// Package auditlogs provides types/client for making requests to /audit/logs.
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+// fakeAuditLogs serves trails filtered by the since day, in ascending order.
+type fakeAuditLogs struct {
+	mu     sync.Mutex
+	trails []Trail
+	fail   int
+}
+
+func (f *fakeAuditLogs) add(trails ...Trail) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.trails = append(f.trails, trails...)
+}
+
+func (f *fakeAuditLogs) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.fail > 0 {
+		f.fail--
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	q := r.URL.Query()
+	since, _ := time.Parse(dateLayout, q.Get("since"))
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	cursor, _ := strconv.Atoi(q.Get("cursor"))
+
+	var trails []Trail
+	for _, t := range f.trails {
+		if !t.Timestamp.Before(since) {
+			trails = append(trails, t)
+		}
+	}
+	trails = trails[cursor:]
+
+	response := AuditLogs{Trails: trails}
+	if len(trails) > limit {
+		response.Trails = trails[:limit]
+		response.Meta.NextCursor = Cursor(strconv.Itoa(cursor + limit))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func TestClient_Tail(t *testing.T) {
+	start := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	fake := &fakeAuditLogs{}
+	fake.add(
+		Trail{ID: 1, Timestamp: start.Add(-time.Hour)},
+		Trail{ID: 2, Timestamp: start},
+		Trail{ID: 3, Timestamp: start.Add(time.Minute)},
+	)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	checkpoint := NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+	receive := func(t *testing.T, trails <-chan Trail, n int) []int {
+		t.Helper()
+		var ids []int
+		for len(ids) < n {
+			select {
+			case trail := <-trails:
+				ids = append(ids, trail.ID)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Tail() got = %v, want %d trails", ids, n)
+			}
+		}
+		return ids
+	}
+	opts := []TailOption{
+		WithSince(start),
+		WithCheckpoint(checkpoint),
+		WithPollInterval(5 * time.Millisecond),
+		WithPageSize(1),
+	}
+
+	t.Run("sends new trails", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		trails, err := c.Tail(ctx, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := receive(t, trails, 2); !reflect.DeepEqual(got, []int{2, 3}) {
+			t.Errorf("Tail() got = %v, want %v", got, []int{2, 3})
+		}
+
+		fake.add(Trail{ID: 4, Timestamp: start.Add(24 * time.Hour)})
+		if got := receive(t, trails, 1); !reflect.DeepEqual(got, []int{4}) {
+			t.Errorf("Tail() got = %v, want %v", got, []int{4})
+		}
+
+		cancel()
+		for range trails {
+			t.Error("Tail() got trail after cancel, want closed channel")
+		}
+	})
+
+	t.Run("resumes from checkpoint", func(t *testing.T) {
+		pos, err := checkpoint.Load(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.Since.Before(start) || len(pos.Seen) == 0 {
+			t.Errorf("checkpoint.Load() got = %+v, want a saved position", pos)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		fake.add(Trail{ID: 5, Timestamp: start.Add(25 * time.Hour)})
+		trails, err := c.Tail(ctx, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := receive(t, trails, 1); !reflect.DeepEqual(got, []int{5}) {
+			t.Errorf("Tail() got = %v, want %v", got, []int{5})
+		}
+	})
+
+	t.Run("backs off on errors", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		fake.mu.Lock()
+		fake.fail = 2
+		fake.mu.Unlock()
+
+		fake.add(Trail{ID: 6, Timestamp: start.Add(49 * time.Hour)})
+
+		var mu sync.Mutex
+		var errs []error
+		trails, err := c.Tail(ctx,
+			WithSince(start.Add(48*time.Hour)),
+			WithPollInterval(time.Hour),
+			WithBackoff(time.Millisecond, 2*time.Millisecond),
+			WithErrorHandler(func(err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, err)
+			}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := receive(t, trails, 1); !reflect.DeepEqual(got, []int{6}) {
+			t.Errorf("Tail() got = %v, want %v", got, []int{6})
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		var e *rest.Error
+		if len(errs) != 2 || !errors.As(errs[0], &e) {
+			t.Errorf("Tail() errors got = %v, want 2 rest errors", errs)
+		}
+	})
+}
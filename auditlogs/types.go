@@ -1,7 +1,13 @@
 // Package auditlogs provides types/client for making requests to /audit/logs.
 package auditlogs
 
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
 
 // GetAuditLogsRequest is the request type for GET /audit/logs
 type GetAuditLogsRequest struct {
@@ -15,11 +21,50 @@ type GetAuditLogsRequest struct {
 	Cursor *int
 	// Return the records in ascending ('ASC') or descending ('DESC') order. This value defaults to 'DESC' order.
 	OrderBy *string
+	// Filters are applied client side to the returned trails, which must match all of them.
+	Filters []Filter
 }
 
 // AuditLogs is the response type for /audit/logs operations.
 type AuditLogs struct {
 	Trails []Trail `json:"trails"`
+	Meta   Meta    `json:"meta"`
+}
+
+// Meta holds the pagination metadata of an /audit/logs response.
+type Meta struct {
+	// The cursor to get the next set of results, empty on the last page.
+	NextCursor Cursor `json:"nextCursor"`
+}
+
+// Cursor is a pagination cursor. The api returns it either as a number or as a string.
+type Cursor string
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (c *Cursor) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case bytes.Equal(b, []byte("null")):
+		*c = ""
+	case len(b) > 0 && b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*c = Cursor(s)
+	default:
+		var n json.Number
+		if err := json.Unmarshal(b, &n); err != nil {
+			return fmt.Errorf("auditlogs: cursor must be a number or a string: %w", err)
+		}
+		*c = Cursor(n.String())
+	}
+	return nil
+}
+
+// Int returns the cursor as an int, for use as GetAuditLogsRequest.Cursor.
+func (c Cursor) Int() (int, error) {
+	return strconv.Atoi(string(c))
 }
 
 // Trail ...
@@ -27,7 +72,7 @@ type Trail struct {
 	ID        int       `json:"id"`
 	IP        string    `json:"ip"`
 	UserAgent string    `json:"userAgent"`
-	Action    string    `json:"action"`
+	Action    Action    `json:"action"`
 	Timestamp time.Time `json:"timestamp"`
 	Message   string    `json:"message"`
 	Data      TrailData `json:"data"`
@@ -4,12 +4,16 @@ package postman
 import (
 	"net/http"
 
+	"github.com/actatum/postman-client/apis"
 	"github.com/actatum/postman-client/apisecurity"
 	"github.com/actatum/postman-client/auditlogs"
 	"github.com/actatum/postman-client/collections"
 	"github.com/actatum/postman-client/environments"
+	"github.com/actatum/postman-client/imports"
+	"github.com/actatum/postman-client/mocks"
 	"github.com/actatum/postman-client/monitors"
 	"github.com/actatum/postman-client/rest"
+	"github.com/actatum/postman-client/scim"
 	"github.com/actatum/postman-client/user"
 	"github.com/actatum/postman-client/webhooks"
 	"github.com/actatum/postman-client/workspaces"
@@ -17,11 +21,15 @@ import (
 
 // ClientSet holds handles to all the different postman endpoint clients.
 type ClientSet struct {
+	apis         *apis.Client
 	apisecurity  *apisecurity.Client
 	auditlogs    *auditlogs.Client
 	collections  *collections.Client
 	environments *environments.Client
+	imports      *imports.Client
+	mocks        *mocks.Client
 	monitors     *monitors.Client
+	scim         *scim.Client
 	users        *user.Client
 	webhooks     *webhooks.Client
 	workspaces   *workspaces.Client
@@ -38,23 +46,46 @@ func NewClientSet(apiKey string, opts ...Option) *ClientSet {
 		o.apply(&options)
 	}
 
-	restClient := rest.NewClient(
-		apiKey,
+	restOpts := []rest.Option{
 		rest.WithHTTPClient(options.httpClient),
-		rest.WithDebugLog(options.debugLog),
-	)
+		rest.WithBaseURL(options.baseURL),
+		rest.WithUserAgent(options.userAgent),
+		rest.WithDefaultHeaders(options.headers),
+		rest.WithRedactedPaths(options.redactPaths...),
+		rest.WithRetryPolicy(options.retryPolicy),
+		rest.WithRateLimit(options.rateLimit.n, options.rateLimit.per),
+		rest.WithMiddleware(options.middleware...),
+		rest.WithCallMiddleware(options.callMW...),
+	}
+	if options.debugLog != nil {
+		restOpts = append(restOpts, rest.WithDebugLog(options.debugLog))
+	}
+	if options.logger != nil {
+		restOpts = append(restOpts, rest.WithLogger(options.logger))
+	}
+
+	restClient := rest.NewClient(apiKey, restOpts...)
 	return &ClientSet{
+		apis:         apis.NewClient(restClient),
 		apisecurity:  apisecurity.NewClient(restClient),
 		auditlogs:    auditlogs.NewClient(restClient),
 		collections:  collections.NewClient(restClient),
 		environments: environments.NewClient(restClient),
+		imports:      imports.NewClient(restClient),
+		mocks:        mocks.NewClient(restClient),
 		monitors:     monitors.NewClient(restClient),
+		scim:         scim.NewClient(restClient),
 		users:        user.NewClient(restClient),
 		webhooks:     webhooks.NewClient(restClient),
 		workspaces:   workspaces.NewClient(restClient),
 	}
 }
 
+// APIs returns a handle to an apis.Client.
+func (cs *ClientSet) APIs() *apis.Client {
+	return cs.apis
+}
+
 // APISecurity returns a handle to an apiesecurity.Client.
 func (cs *ClientSet) APISecurity() *apisecurity.Client {
 	return cs.apisecurity
@@ -75,11 +106,26 @@ func (cs *ClientSet) Environments() *environments.Client {
 	return cs.environments
 }
 
+// Imports returns a handle to an imports.Client.
+func (cs *ClientSet) Imports() *imports.Client {
+	return cs.imports
+}
+
+// Mocks returns a handle to a mocks.Client.
+func (cs *ClientSet) Mocks() *mocks.Client {
+	return cs.mocks
+}
+
 // Monitors returns a handle to a monitors.Client.
 func (cs *ClientSet) Monitors() *monitors.Client {
 	return cs.monitors
 }
 
+// SCIM returns a handle to a scim.Client. Its requests must be authenticated with a scim api key.
+func (cs *ClientSet) SCIM() *scim.Client {
+	return cs.scim
+}
+
 // Users returns a handle to a user.Client.
 func (cs *ClientSet) Users() *user.Client {
 	return cs.users
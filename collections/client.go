@@ -133,6 +133,30 @@ func (c *Client) Delete(
 	return response.Collection, err
 }
 
+// Patch sends a PATCH request to /collections/:id, changing only the info, variables, auth
+// and events set in req.
+func (c *Client) Patch(
+	ctx context.Context,
+	id string,
+	req PatchCollectionRequest,
+	opts ...rest.RequestOption,
+) (Collection, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		patchCollectionWrapper{Collection: req},
+	)
+	if err != nil {
+		return Collection{}, err
+	}
+
+	var response collectionWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Collection, err
+}
+
 // CreateFork sends a POST request to /collections/fork/:id
 func (c *Client) CreateFork(
 	ctx context.Context,
@@ -177,3 +201,296 @@ func (c *Client) MergeFork(
 
 	return response.Collection, err
 }
+
+// CreateFolder sends a POST request to /collections/:id/folders.
+func (c *Client) CreateFolder(
+	ctx context.Context,
+	collectionID string,
+	folder Folder,
+	opts ...rest.RequestOption,
+) (Folder, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/%s/folders", c.restClient.BaseURL(), path, collectionID),
+		folder,
+	)
+	if err != nil {
+		return Folder{}, err
+	}
+
+	var response modelWrapper[Folder]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// GetFolder sends a GET request to /collections/:id/folders/:folderId.
+func (c *Client) GetFolder(
+	ctx context.Context,
+	collectionID string,
+	folderID string,
+	opts ...rest.RequestOption,
+) (Folder, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s/folders/%s", c.restClient.BaseURL(), path, collectionID, folderID),
+		nil,
+	)
+	if err != nil {
+		return Folder{}, err
+	}
+
+	var response modelWrapper[Folder]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// UpdateFolder sends a PUT request to /collections/:id/folders/:folderId.
+func (c *Client) UpdateFolder(
+	ctx context.Context,
+	collectionID string,
+	folderID string,
+	folder Folder,
+	opts ...rest.RequestOption,
+) (Folder, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/%s/folders/%s", c.restClient.BaseURL(), path, collectionID, folderID),
+		folder,
+	)
+	if err != nil {
+		return Folder{}, err
+	}
+
+	var response modelWrapper[Folder]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// DeleteFolder sends a DELETE request to /collections/:id/folders/:folderId.
+func (c *Client) DeleteFolder(
+	ctx context.Context,
+	collectionID string,
+	folderID string,
+	opts ...rest.RequestOption,
+) (Folder, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s/folders/%s", c.restClient.BaseURL(), path, collectionID, folderID),
+		nil,
+	)
+	if err != nil {
+		return Folder{}, err
+	}
+
+	var response modelWrapper[Folder]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// CreateRequest sends a POST request to /collections/:id/requests?folder=:folderId.
+func (c *Client) CreateRequest(
+	ctx context.Context,
+	collectionID string,
+	folderID string,
+	request RequestModel,
+	opts ...rest.RequestOption,
+) (RequestModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/%s/requests", c.restClient.BaseURL(), path, collectionID),
+		request,
+	)
+	if err != nil {
+		return RequestModel{}, err
+	}
+
+	if folderID != "" {
+		q := r.URL.Query()
+		q.Add("folder", folderID)
+		r.URL.RawQuery = q.Encode()
+	}
+
+	var response modelWrapper[RequestModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// GetRequest sends a GET request to /collections/:id/requests/:requestId.
+func (c *Client) GetRequest(
+	ctx context.Context,
+	collectionID string,
+	requestID string,
+	opts ...rest.RequestOption,
+) (RequestModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s/requests/%s", c.restClient.BaseURL(), path, collectionID, requestID),
+		nil,
+	)
+	if err != nil {
+		return RequestModel{}, err
+	}
+
+	var response modelWrapper[RequestModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// UpdateRequest sends a PUT request to /collections/:id/requests/:requestId.
+func (c *Client) UpdateRequest(
+	ctx context.Context,
+	collectionID string,
+	requestID string,
+	request RequestModel,
+	opts ...rest.RequestOption,
+) (RequestModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/%s/requests/%s", c.restClient.BaseURL(), path, collectionID, requestID),
+		request,
+	)
+	if err != nil {
+		return RequestModel{}, err
+	}
+
+	var response modelWrapper[RequestModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// DeleteRequest sends a DELETE request to /collections/:id/requests/:requestId.
+func (c *Client) DeleteRequest(
+	ctx context.Context,
+	collectionID string,
+	requestID string,
+	opts ...rest.RequestOption,
+) (RequestModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s/requests/%s", c.restClient.BaseURL(), path, collectionID, requestID),
+		nil,
+	)
+	if err != nil {
+		return RequestModel{}, err
+	}
+
+	var response modelWrapper[RequestModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// CreateResponse sends a POST request to /collections/:id/responses?request=:requestId.
+func (c *Client) CreateResponse(
+	ctx context.Context,
+	collectionID string,
+	requestID string,
+	res ResponseModel,
+	opts ...rest.RequestOption,
+) (ResponseModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/%s/responses", c.restClient.BaseURL(), path, collectionID),
+		res,
+	)
+	if err != nil {
+		return ResponseModel{}, err
+	}
+
+	if requestID != "" {
+		q := r.URL.Query()
+		q.Add("request", requestID)
+		r.URL.RawQuery = q.Encode()
+	}
+
+	var response modelWrapper[ResponseModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// GetResponse sends a GET request to /collections/:id/responses/:responseId.
+func (c *Client) GetResponse(
+	ctx context.Context,
+	collectionID string,
+	responseID string,
+	opts ...rest.RequestOption,
+) (ResponseModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s/responses/%s", c.restClient.BaseURL(), path, collectionID, responseID),
+		nil,
+	)
+	if err != nil {
+		return ResponseModel{}, err
+	}
+
+	var response modelWrapper[ResponseModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// UpdateResponse sends a PUT request to /collections/:id/responses/:responseId.
+func (c *Client) UpdateResponse(
+	ctx context.Context,
+	collectionID string,
+	responseID string,
+	res ResponseModel,
+	opts ...rest.RequestOption,
+) (ResponseModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/%s/responses/%s", c.restClient.BaseURL(), path, collectionID, responseID),
+		res,
+	)
+	if err != nil {
+		return ResponseModel{}, err
+	}
+
+	var response modelWrapper[ResponseModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
+
+// DeleteResponse sends a DELETE request to /collections/:id/responses/:responseId.
+func (c *Client) DeleteResponse(
+	ctx context.Context,
+	collectionID string,
+	responseID string,
+	opts ...rest.RequestOption,
+) (ResponseModel, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s/responses/%s", c.restClient.BaseURL(), path, collectionID, responseID),
+		nil,
+	)
+	if err != nil {
+		return ResponseModel{}, err
+	}
+
+	var response modelWrapper[ResponseModel]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Data, err
+}
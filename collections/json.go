@@ -0,0 +1,591 @@
+// Package collections provides types/client for making requests to /collections.
+package collections
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// unmarshalObject decodes the json object into v, a pointer to a struct, and stores the fields
+// of the object which v does not declare in extra.
+func unmarshalObject(data []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for _, name := range fieldNames(reflect.TypeOf(v).Elem()) {
+		delete(fields, name)
+	}
+
+	*extra = nil
+	if len(fields) > 0 {
+		*extra = fields
+	}
+	return nil
+}
+
+// marshalObject encodes v, a struct, as a json object followed by the extra fields it does not declare.
+// Empty slices and maps which are not nil are kept, even though their fields are tagged omitempty,
+// since they were present in the decoded object.
+func marshalObject(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return b, err
+	}
+	empty := emptyFields(reflect.ValueOf(v))
+	if len(extra) == 0 && len(empty) == 0 {
+		return b, nil
+	}
+
+	known := make(map[string]bool)
+	for _, name := range fieldNames(reflect.TypeOf(v)) {
+		known[name] = true
+	}
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		if !known[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(b[:len(b)-1])
+	write := func(k string, value []byte) error {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(k)
+		if err != nil {
+			return err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+		return nil
+	}
+	for _, f := range empty {
+		if err = write(f.name, f.value); err != nil {
+			return nil, err
+		}
+	}
+	for _, k := range keys {
+		if err = write(k, extra[k]); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+type emptyField struct {
+	name  string
+	value []byte
+}
+
+// emptyFields returns the omitempty fields of the struct v holding an empty, non nil, slice or map.
+func emptyFields(v reflect.Value) []emptyField {
+	var fields []emptyField
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() || !strings.Contains(opts, "omitempty") {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fv := v.Field(i)
+		switch {
+		case fv.Kind() == reflect.Slice && !fv.IsNil() && fv.Len() == 0:
+			fields = append(fields, emptyField{name: name, value: []byte("[]")})
+		case fv.Kind() == reflect.Map && !fv.IsNil() && fv.Len() == 0:
+			fields = append(fields, emptyField{name: name, value: []byte("{}")})
+		}
+	}
+	return fields
+}
+
+// fieldNames returns the json field names declared by the struct type t.
+func fieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func isString(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '"'
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (d *Description) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		*d = Description{}
+		return json.Unmarshal(data, &d.Content)
+	}
+
+	type description Description
+	return unmarshalObject(data, (*description)(d), &d.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (d Description) MarshalJSON() ([]byte, error) {
+	if d.Type == "" && d.Version == nil && d.Extra == nil {
+		return json.Marshal(d.Content)
+	}
+
+	type description Description
+	return marshalObject(description(d), d.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (l *Lines) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*l = strings.Split(s, "\n")
+		return nil
+	}
+
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (s *Segments) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		var segment string
+		if err := json.Unmarshal(data, &segment); err != nil {
+			return err
+		}
+		*s = Segments{segment}
+		return nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	segments := make(Segments, 0, len(raw))
+	for _, r := range raw {
+		if isString(r) {
+			var segment string
+			if err := json.Unmarshal(r, &segment); err != nil {
+				return err
+			}
+			segments = append(segments, segment)
+			continue
+		}
+
+		// Path segments may also be objects holding the segment in their value.
+		var segment struct {
+			Value string `json:"value"`
+		}
+		if err := json.Unmarshal(r, &segment); err != nil {
+			return err
+		}
+		segments = append(segments, segment.Value)
+	}
+	*s = segments
+
+	return nil
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (h *HeaderList) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		return json.Unmarshal(data, (*[]Header)(h))
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var headers HeaderList
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		headers = append(headers, Header{Key: strings.TrimSpace(key), Value: strings.TrimSpace(value)})
+	}
+	*h = headers
+
+	return scanner.Err()
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (u *URL) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		*u = URL{}
+		return json.Unmarshal(data, &u.Raw)
+	}
+
+	type url URL
+	return unmarshalObject(data, (*url)(u), &u.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if u.Protocol == "" && u.Host == nil && u.Path == nil && u.Port == "" && u.Query == nil &&
+		u.Hash == "" && u.Variables == nil && u.Extra == nil {
+		return json.Marshal(u.Raw)
+	}
+
+	type url URL
+	return marshalObject(url(u), u.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (r *Request) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		*r = Request{URL: &URL{}}
+		return json.Unmarshal(data, &r.URL.Raw)
+	}
+
+	type request Request
+	return unmarshalObject(data, (*request)(r), &r.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (r Request) MarshalJSON() ([]byte, error) {
+	type request Request
+	return marshalObject(request(r), r.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (c *CollectionDetails) UnmarshalJSON(data []byte) error {
+	type collectionDetails CollectionDetails
+	return unmarshalObject(data, (*collectionDetails)(c), &c.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (c CollectionDetails) MarshalJSON() ([]byte, error) {
+	type collectionDetails CollectionDetails
+	return marshalObject(collectionDetails(c), c.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (i *Info) UnmarshalJSON(data []byte) error {
+	type info Info
+	return unmarshalObject(data, (*info)(i), &i.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (i Info) MarshalJSON() ([]byte, error) {
+	type info Info
+	return marshalObject(info(i), i.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type item Item
+	return unmarshalObject(data, (*item)(i), &i.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (i Item) MarshalJSON() ([]byte, error) {
+	type item Item
+	return marshalObject(item(i), i.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type event Event
+	return unmarshalObject(data, (*event)(e), &e.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (e Event) MarshalJSON() ([]byte, error) {
+	type event Event
+	return marshalObject(event(e), e.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (s *Script) UnmarshalJSON(data []byte) error {
+	type script Script
+	return unmarshalObject(data, (*script)(s), &s.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (s Script) MarshalJSON() ([]byte, error) {
+	type script Script
+	return marshalObject(script(s), s.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (q *QueryParam) UnmarshalJSON(data []byte) error {
+	type queryParam QueryParam
+	return unmarshalObject(data, (*queryParam)(q), &q.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (q QueryParam) MarshalJSON() ([]byte, error) {
+	type queryParam QueryParam
+	return marshalObject(queryParam(q), q.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (h *Header) UnmarshalJSON(data []byte) error {
+	type header Header
+	return unmarshalObject(data, (*header)(h), &h.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (h Header) MarshalJSON() ([]byte, error) {
+	type header Header
+	return marshalObject(header(h), h.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	type body Body
+	return unmarshalObject(data, (*body)(b), &b.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (b Body) MarshalJSON() ([]byte, error) {
+	type body Body
+	return marshalObject(body(b), b.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (p *URLEncodedParam) UnmarshalJSON(data []byte) error {
+	type urlEncodedParam URLEncodedParam
+	return unmarshalObject(data, (*urlEncodedParam)(p), &p.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (p URLEncodedParam) MarshalJSON() ([]byte, error) {
+	type urlEncodedParam URLEncodedParam
+	return marshalObject(urlEncodedParam(p), p.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (p *FormParam) UnmarshalJSON(data []byte) error {
+	type formParam FormParam
+	return unmarshalObject(data, (*formParam)(p), &p.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (p FormParam) MarshalJSON() ([]byte, error) {
+	type formParam FormParam
+	return marshalObject(formParam(p), p.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (a *Auth) UnmarshalJSON(data []byte) error {
+	type auth Auth
+	return unmarshalObject(data, (*auth)(a), &a.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (a Auth) MarshalJSON() ([]byte, error) {
+	type auth Auth
+	return marshalObject(auth(a), a.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (v *Variable) UnmarshalJSON(data []byte) error {
+	type variable Variable
+	return unmarshalObject(data, (*variable)(v), &v.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (v Variable) MarshalJSON() ([]byte, error) {
+	type variable Variable
+	return marshalObject(variable(v), v.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (p *Proxy) UnmarshalJSON(data []byte) error {
+	type proxy Proxy
+	return unmarshalObject(data, (*proxy)(p), &p.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (p Proxy) MarshalJSON() ([]byte, error) {
+	type proxy Proxy
+	return marshalObject(proxy(p), p.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (c *Certificate) UnmarshalJSON(data []byte) error {
+	type certificate Certificate
+	return unmarshalObject(data, (*certificate)(c), &c.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (c Certificate) MarshalJSON() ([]byte, error) {
+	type certificate Certificate
+	return marshalObject(certificate(c), c.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	type response Response
+	return unmarshalObject(data, (*response)(r), &r.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (r Response) MarshalJSON() ([]byte, error) {
+	type response Response
+	return marshalObject(response(r), r.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (c *Cookie) UnmarshalJSON(data []byte) error {
+	type cookie Cookie
+	return unmarshalObject(data, (*cookie)(c), &c.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (c Cookie) MarshalJSON() ([]byte, error) {
+	type cookie Cookie
+	return marshalObject(cookie(c), c.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (f *Folder) UnmarshalJSON(data []byte) error {
+	type folder Folder
+	return unmarshalObject(data, (*folder)(f), &f.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (f Folder) MarshalJSON() ([]byte, error) {
+	type folder Folder
+	return marshalObject(folder(f), f.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (r *RequestModel) UnmarshalJSON(data []byte) error {
+	type requestModel RequestModel
+	return unmarshalObject(data, (*requestModel)(r), &r.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (r RequestModel) MarshalJSON() ([]byte, error) {
+	type requestModel RequestModel
+	return marshalObject(requestModel(r), r.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (r *ResponseModel) UnmarshalJSON(data []byte) error {
+	type responseModel ResponseModel
+	return unmarshalObject(data, (*responseModel)(r), &r.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (r ResponseModel) MarshalJSON() ([]byte, error) {
+	type responseModel ResponseModel
+	return marshalObject(responseModel(r), r.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (f *Fork) UnmarshalJSON(data []byte) error {
+	type fork Fork
+	return unmarshalObject(data, (*fork)(f), &f.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (f Fork) MarshalJSON() ([]byte, error) {
+	type fork Fork
+	return marshalObject(fork(f), f.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (f *File) UnmarshalJSON(data []byte) error {
+	type file File
+	return unmarshalObject(data, (*file)(f), &f.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (f File) MarshalJSON() ([]byte, error) {
+	type file File
+	return marshalObject(file(f), f.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (g *GraphQL) UnmarshalJSON(data []byte) error {
+	type graphQL GraphQL
+	return unmarshalObject(data, (*graphQL)(g), &g.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (g GraphQL) MarshalJSON() ([]byte, error) {
+	type graphQL GraphQL
+	return marshalObject(graphQL(g), g.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (a *AuthAttribute) UnmarshalJSON(data []byte) error {
+	type authAttribute AuthAttribute
+	return unmarshalObject(data, (*authAttribute)(a), &a.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (a AuthAttribute) MarshalJSON() ([]byte, error) {
+	type authAttribute AuthAttribute
+	return marshalObject(authAttribute(a), a.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (c *CertificateFile) UnmarshalJSON(data []byte) error {
+	type certificateFile CertificateFile
+	return unmarshalObject(data, (*certificateFile)(c), &c.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (c CertificateFile) MarshalJSON() ([]byte, error) {
+	type certificateFile CertificateFile
+	return marshalObject(certificateFile(c), c.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (r *ResponseCode) UnmarshalJSON(data []byte) error {
+	type responseCode ResponseCode
+	return unmarshalObject(data, (*responseCode)(r), &r.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (r ResponseCode) MarshalJSON() ([]byte, error) {
+	type responseCode ResponseCode
+	return marshalObject(responseCode(r), r.Extra)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (k *KeyValue) UnmarshalJSON(data []byte) error {
+	type keyValue KeyValue
+	return unmarshalObject(data, (*keyValue)(k), &k.Extra)
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (k KeyValue) MarshalJSON() ([]byte, error) {
+	type keyValue KeyValue
+	return marshalObject(keyValue(k), k.Extra)
+}
@@ -1,7 +1,10 @@
 // Package collections provides types/client for making requests to /collections.
 package collections
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Possible values for merge fork strategies.
 const (
@@ -9,6 +12,53 @@ const (
 	MergeStrategyUpdateSourceWithDestination = "updateSourceWithDestination"
 )
 
+// SchemaV21 is the schema url of the v2.1.0 collection format.
+const SchemaV21 = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// Possible values for request body modes.
+const (
+	BodyModeRaw        = "raw"
+	BodyModeURLEncoded = "urlencoded"
+	BodyModeFormData   = "formdata"
+	BodyModeFile       = "file"
+	BodyModeGraphQL    = "graphql"
+)
+
+// Possible values for auth types.
+const (
+	AuthTypeNoAuth   = "noauth"
+	AuthTypeAPIKey   = "apikey"
+	AuthTypeAWSV4    = "awsv4"
+	AuthTypeBasic    = "basic"
+	AuthTypeBearer   = "bearer"
+	AuthTypeDigest   = "digest"
+	AuthTypeEdgeGrid = "edgegrid"
+	AuthTypeHawk     = "hawk"
+	AuthTypeNTLM     = "ntlm"
+	AuthTypeOAuth1   = "oauth1"
+	AuthTypeOAuth2   = "oauth2"
+)
+
+// Possible values for variable types.
+const (
+	VariableTypeString  = "string"
+	VariableTypeBoolean = "boolean"
+	VariableTypeNumber  = "number"
+	VariableTypeAny     = "any"
+)
+
+// Possible values for event listeners.
+const (
+	EventListenPreRequest = "prerequest"
+	EventListenTest       = "test"
+)
+
+// Possible values for form data parameter types.
+const (
+	FormParamTypeText = "text"
+	FormParamTypeFile = "file"
+)
+
 // Collection ...
 type Collection struct {
 	ID        string    `json:"id,omitempty"`
@@ -21,62 +71,318 @@ type Collection struct {
 	Fork      Fork      `json:"fork,omitempty"`
 }
 
-// CollectionDetails ...
+// CollectionDetails is a collection in the v2.1.0 format.
+//
+// Fields which are not modeled are kept in Extra, so a collection can be fetched and
+// sent back without losing any data. The same holds for every type making up a collection.
 type CollectionDetails struct {
-	Info  Info   `json:"info,omitempty"`
-	Items []Item `json:"item,omitempty"`
+	Info                    Info                       `json:"info,omitempty"`
+	Items                   []Item                     `json:"item,omitempty"`
+	Events                  []Event                    `json:"event,omitempty"`
+	Variables               []Variable                 `json:"variable,omitempty"`
+	Auth                    *Auth                      `json:"auth,omitempty"`
+	ProtocolProfileBehavior map[string]interface{}     `json:"protocolProfileBehavior,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-"`
 }
 
 // Info ...
 type Info struct {
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	PostmanID   string    `json:"_postman_id,omitempty"`
-	Schema      string    `json:"schema,omitempty"`
-	UpdatedAt   time.Time `json:"updatedAt,omitempty"`
-	Fork        Fork      `json:"fork,omitempty"`
+	Name        string                     `json:"name,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	PostmanID   string                     `json:"_postman_id,omitempty"`
+	Schema      string                     `json:"schema,omitempty"`
+	Version     interface{}                `json:"version,omitempty"`
+	UpdatedAt   *time.Time                 `json:"updatedAt,omitempty"`
+	Fork        *Fork                      `json:"fork,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// Description is a description of a collection, item or value.
+// It is encoded as a plain string unless it has a type or version.
+type Description struct {
+	Content string                     `json:"content,omitempty"`
+	Type    string                     `json:"type,omitempty"`
+	Version interface{}                `json:"version,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
 }
 
 // Fork ...
 type Fork struct {
-	Label     string    `json:"label,omitempty"`
-	CreatedAt time.Time `json:"createdAt,omitempty"`
-	From      string    `json:"from,omitempty"`
+	Label     string                     `json:"label,omitempty"`
+	CreatedAt time.Time                  `json:"createdAt,omitempty"`
+	From      string                     `json:"from,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
 }
 
-// Item ...
+// Item is either a request or a folder of items, in which case Items is set and Request is nil.
 type Item struct {
-	Name     string      `json:"name,omitempty"`
-	ID       string      `json:"id,omitempty"`
-	Events   []Event     `json:"event,omitempty"`
-	Request  Request     `json:"request,omitempty"`
-	Response interface{} `json:"response,omitempty"`
+	ID                      string                     `json:"id,omitempty"`
+	Name                    string                     `json:"name,omitempty"`
+	Description             *Description               `json:"description,omitempty"`
+	Variables               []Variable                 `json:"variable,omitempty"`
+	Events                  []Event                    `json:"event,omitempty"`
+	Request                 *Request                   `json:"request,omitempty"`
+	Responses               []Response                 `json:"response,omitempty"`
+	Items                   []Item                     `json:"item,omitempty"`
+	Auth                    *Auth                      `json:"auth,omitempty"`
+	ProtocolProfileBehavior map[string]interface{}     `json:"protocolProfileBehavior,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+// IsFolder reports whether the item is a folder.
+func (i Item) IsFolder() bool {
+	return i.Request == nil
 }
 
 // Event ...
 type Event struct {
-	Listen string `json:"listen,omitempty"`
-	Script Script `json:"script,omitempty"`
+	ID       string                     `json:"id,omitempty"`
+	Listen   string                     `json:"listen,omitempty"`
+	Script   Script                     `json:"script,omitempty"`
+	Disabled bool                       `json:"disabled,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
 }
 
 // Script ...
 type Script struct {
-	ID   string   `json:"id,omitempty"`
-	Exec []string `json:"exec,omitempty"`
-	Type string   `json:"type,omitempty"`
+	ID    string                     `json:"id,omitempty"`
+	Type  string                     `json:"type,omitempty"`
+	Exec  Lines                      `json:"exec,omitempty"`
+	Src   *URL                       `json:"src,omitempty"`
+	Name  string                     `json:"name,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-// Request ...
+// Lines are the lines of a script. They are decoded from either a string or an array of strings.
+type Lines []string
+
+// Request is a request of an item. It is decoded from either a url string or an object.
 type Request struct {
-	URL     string   `json:"url,omitempty"`
-	Method  string   `json:"method,omitempty"`
-	Headers []Header `json:"header,omitempty"`
+	URL         *URL                       `json:"url,omitempty"`
+	Auth        *Auth                      `json:"auth,omitempty"`
+	Proxy       *Proxy                     `json:"proxy,omitempty"`
+	Certificate *Certificate               `json:"certificate,omitempty"`
+	Method      string                     `json:"method,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	Headers     HeaderList                 `json:"header,omitempty"`
+	Body        *Body                      `json:"body,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// URL is the url of a request. It is decoded from either a string or an object,
+// and encoded as a string when only Raw is set.
+type URL struct {
+	Raw       string                     `json:"raw,omitempty"`
+	Protocol  string                     `json:"protocol,omitempty"`
+	Host      Segments                   `json:"host,omitempty"`
+	Path      Segments                   `json:"path,omitempty"`
+	Port      string                     `json:"port,omitempty"`
+	Query     []QueryParam               `json:"query,omitempty"`
+	Hash      string                     `json:"hash,omitempty"`
+	Variables []Variable                 `json:"variable,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
 }
 
+// Segments are the host or path segments of a url. They are decoded from either a string or an array.
+type Segments []string
+
+// QueryParam ...
+type QueryParam struct {
+	Key         string                     `json:"key"`
+	Value       string                     `json:"value"`
+	Disabled    bool                       `json:"disabled,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// HeaderList is a list of headers. It is decoded from either an array or a raw header string.
+type HeaderList []Header
+
 // Header ...
 type Header struct {
-	Key   string `json:"key,omitempty"`
-	Value string `json:"value,omitempty"`
+	Key         string                     `json:"key"`
+	Value       string                     `json:"value"`
+	Disabled    bool                       `json:"disabled,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// Body is the body of a request. Mode determines which of the fields holds the body.
+type Body struct {
+	Mode       string                     `json:"mode,omitempty"`
+	Raw        string                     `json:"raw,omitempty"`
+	URLEncoded []URLEncodedParam          `json:"urlencoded,omitempty"`
+	FormData   []FormParam                `json:"formdata,omitempty"`
+	File       *File                      `json:"file,omitempty"`
+	GraphQL    *GraphQL                   `json:"graphql,omitempty"`
+	Options    map[string]interface{}     `json:"options,omitempty"`
+	Disabled   bool                       `json:"disabled,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+// URLEncodedParam ...
+type URLEncodedParam struct {
+	Key         string                     `json:"key"`
+	Value       string                     `json:"value,omitempty"`
+	Disabled    bool                       `json:"disabled,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// FormParam is a form data parameter. Src holds the file path(s) of parameters of type file.
+type FormParam struct {
+	Key         string                     `json:"key"`
+	Value       string                     `json:"value,omitempty"`
+	Src         interface{}                `json:"src,omitempty"`
+	Type        string                     `json:"type,omitempty"`
+	ContentType string                     `json:"contentType,omitempty"`
+	Disabled    bool                       `json:"disabled,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// File ...
+type File struct {
+	Src     string                     `json:"src,omitempty"`
+	Content string                     `json:"content,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+// GraphQL ...
+type GraphQL struct {
+	Query     string                     `json:"query,omitempty"`
+	Variables string                     `json:"variables,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+// Auth holds the authentication parameters of a collection, folder or request.
+// The parameters of the auth type in use are returned by Attributes.
+type Auth struct {
+	Type     string                     `json:"type"`
+	NoAuth   []AuthAttribute            `json:"noauth,omitempty"`
+	APIKey   []AuthAttribute            `json:"apikey,omitempty"`
+	AWSV4    []AuthAttribute            `json:"awsv4,omitempty"`
+	Basic    []AuthAttribute            `json:"basic,omitempty"`
+	Bearer   []AuthAttribute            `json:"bearer,omitempty"`
+	Digest   []AuthAttribute            `json:"digest,omitempty"`
+	EdgeGrid []AuthAttribute            `json:"edgegrid,omitempty"`
+	Hawk     []AuthAttribute            `json:"hawk,omitempty"`
+	NTLM     []AuthAttribute            `json:"ntlm,omitempty"`
+	OAuth1   []AuthAttribute            `json:"oauth1,omitempty"`
+	OAuth2   []AuthAttribute            `json:"oauth2,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
+}
+
+// Attributes returns the parameters of the auth type in use.
+func (a Auth) Attributes() []AuthAttribute {
+	switch a.Type {
+	case AuthTypeNoAuth:
+		return a.NoAuth
+	case AuthTypeAPIKey:
+		return a.APIKey
+	case AuthTypeAWSV4:
+		return a.AWSV4
+	case AuthTypeBasic:
+		return a.Basic
+	case AuthTypeBearer:
+		return a.Bearer
+	case AuthTypeDigest:
+		return a.Digest
+	case AuthTypeEdgeGrid:
+		return a.EdgeGrid
+	case AuthTypeHawk:
+		return a.Hawk
+	case AuthTypeNTLM:
+		return a.NTLM
+	case AuthTypeOAuth1:
+		return a.OAuth1
+	case AuthTypeOAuth2:
+		return a.OAuth2
+	}
+
+	var attributes []AuthAttribute
+	if raw, ok := a.Extra[a.Type]; ok {
+		_ = json.Unmarshal(raw, &attributes)
+	}
+	return attributes
+}
+
+// AuthAttribute ...
+type AuthAttribute struct {
+	Key   string                     `json:"key"`
+	Value interface{}                `json:"value,omitempty"`
+	Type  string                     `json:"type,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Variable is a collection, folder, item or url path variable.
+type Variable struct {
+	ID          string                     `json:"id,omitempty"`
+	Key         string                     `json:"key,omitempty"`
+	Value       interface{}                `json:"value,omitempty"`
+	Type        string                     `json:"type,omitempty"`
+	Name        string                     `json:"name,omitempty"`
+	Description *Description               `json:"description,omitempty"`
+	System      bool                       `json:"system,omitempty"`
+	Disabled    bool                       `json:"disabled,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// Proxy ...
+type Proxy struct {
+	Match    string                     `json:"match,omitempty"`
+	Host     string                     `json:"host,omitempty"`
+	Port     int                        `json:"port,omitempty"`
+	Tunnel   bool                       `json:"tunnel,omitempty"`
+	Disabled bool                       `json:"disabled,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
+}
+
+// Certificate ...
+type Certificate struct {
+	Name       string                     `json:"name,omitempty"`
+	Matches    []string                   `json:"matches,omitempty"`
+	Key        *CertificateFile           `json:"key,omitempty"`
+	Cert       *CertificateFile           `json:"cert,omitempty"`
+	Passphrase string                     `json:"passphrase,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+// CertificateFile ...
+type CertificateFile struct {
+	Src   string                     `json:"src,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Response is a response saved with an item.
+type Response struct {
+	ID              string                     `json:"id,omitempty"`
+	Name            string                     `json:"name,omitempty"`
+	OriginalRequest *Request                   `json:"originalRequest,omitempty"`
+	ResponseTime    interface{}                `json:"responseTime,omitempty"`
+	Timings         interface{}                `json:"timings,omitempty"`
+	Headers         HeaderList                 `json:"header,omitempty"`
+	Cookies         []Cookie                   `json:"cookie,omitempty"`
+	Body            string                     `json:"body,omitempty"`
+	Status          string                     `json:"status,omitempty"`
+	Code            int                        `json:"code,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+// Cookie ...
+type Cookie struct {
+	Domain     string                     `json:"domain"`
+	Expires    interface{}                `json:"expires,omitempty"`
+	MaxAge     string                     `json:"maxAge,omitempty"`
+	HostOnly   bool                       `json:"hostOnly,omitempty"`
+	HTTPOnly   bool                       `json:"httpOnly,omitempty"`
+	Name       string                     `json:"name,omitempty"`
+	Path       string                     `json:"path"`
+	Secure     bool                       `json:"secure,omitempty"`
+	Session    bool                       `json:"session,omitempty"`
+	Value      string                     `json:"value,omitempty"`
+	Extensions []interface{}              `json:"extensions,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
 }
 
 // MergeForkRequest ...
@@ -86,6 +392,106 @@ type MergeForkRequest struct {
 	Destination string `json:"destination,omitempty"`
 }
 
+// PatchCollectionRequest is the request type for PATCH /collections/:id. Only the set fields are changed.
+type PatchCollectionRequest struct {
+	Info      *PatchInfo `json:"info,omitempty"`
+	Variables []Variable `json:"variables,omitempty"`
+	Auth      *Auth      `json:"auth,omitempty"`
+	Events    []Event    `json:"events,omitempty"`
+}
+
+// PatchInfo holds the collection info fields changed by PATCH /collections/:id.
+type PatchInfo struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Folder is a folder of a collection, as returned by the /collections/:id/folders endpoints.
+type Folder struct {
+	ID            string                     `json:"id,omitempty"` // Output only.
+	Name          string                     `json:"name,omitempty"`
+	Description   string                     `json:"description,omitempty"`
+	Folder        string                     `json:"folder,omitempty"`     // The id of the parent folder.
+	Collection    string                     `json:"collection,omitempty"` // Output only.
+	Order         []string                   `json:"order,omitempty"`
+	FoldersOrder  []string                   `json:"folders_order,omitempty"`
+	Owner         string                     `json:"owner,omitempty"`         // Output only.
+	LastUpdatedBy string                     `json:"lastUpdatedBy,omitempty"` // Output only.
+	CreatedAt     *time.Time                 `json:"createdAt,omitempty"`     // Output only.
+	UpdatedAt     *time.Time                 `json:"updatedAt,omitempty"`     // Output only.
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+// RequestModel is a request of a collection, as returned by the /collections/:id/requests endpoints.
+// Unlike Request it uses the flat format of the item endpoints.
+type RequestModel struct {
+	ID             string                     `json:"id,omitempty"` // Output only.
+	Name           string                     `json:"name,omitempty"`
+	Description    string                     `json:"description,omitempty"`
+	URL            string                     `json:"url,omitempty"`
+	Method         string                     `json:"method,omitempty"`
+	Headers        []KeyValue                 `json:"headerData,omitempty"`
+	QueryParams    []KeyValue                 `json:"queryParams,omitempty"`
+	DataMode       string                     `json:"dataMode,omitempty"`
+	Data           []KeyValue                 `json:"data,omitempty"`
+	RawModeData    string                     `json:"rawModeData,omitempty"`
+	Auth           *Auth                      `json:"auth,omitempty"`
+	Events         []Event                    `json:"events,omitempty"`
+	Folder         string                     `json:"folder,omitempty"`     // Output only.
+	Collection     string                     `json:"collection,omitempty"` // Output only.
+	ResponsesOrder []string                   `json:"responses_order,omitempty"`
+	Owner          string                     `json:"owner,omitempty"`     // Output only.
+	CreatedAt      *time.Time                 `json:"createdAt,omitempty"` // Output only.
+	UpdatedAt      *time.Time                 `json:"updatedAt,omitempty"` // Output only.
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+// ResponseModel is a saved response of a request, as returned by the /collections/:id/responses endpoints.
+type ResponseModel struct {
+	ID           string                     `json:"id,omitempty"` // Output only.
+	Name         string                     `json:"name,omitempty"`
+	Status       string                     `json:"status,omitempty"`
+	ResponseCode *ResponseCode              `json:"responseCode,omitempty"`
+	Headers      []KeyValue                 `json:"headers,omitempty"`
+	Cookies      []Cookie                   `json:"cookies,omitempty"`
+	Text         string                     `json:"text,omitempty"`
+	Language     string                     `json:"language,omitempty"`
+	Owner        string                     `json:"owner,omitempty"`     // Output only.
+	CreatedAt    *time.Time                 `json:"createdAt,omitempty"` // Output only.
+	UpdatedAt    *time.Time                 `json:"updatedAt,omitempty"` // Output only.
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+// ResponseCode is the status code of a saved response.
+type ResponseCode struct {
+	Code  int                        `json:"code"`
+	Name  string                     `json:"name"`
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// KeyValue is a header, query parameter or body parameter of a RequestModel or ResponseModel.
+type KeyValue struct {
+	Key         string                     `json:"key"`
+	Value       string                     `json:"value"`
+	Description string                     `json:"description,omitempty"`
+	Type        string                     `json:"type,omitempty"`
+	Enabled     *bool                      `json:"enabled,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// ModelMeta describes the model and action of an item operation.
+type ModelMeta struct {
+	Model  string `json:"model"`
+	Action string `json:"action"`
+}
+
+type modelWrapper[T any] struct {
+	Meta     ModelMeta `json:"meta"`
+	ModelID  string    `json:"model_id"`
+	Revision int64     `json:"revision"`
+	Data     T         `json:"data"`
+}
+
 type collectionWrapper struct {
 	Collection  Collection   `json:"collection,omitempty"`
 	Collections []Collection `json:"collections,omitempty"`
@@ -94,3 +500,7 @@ type collectionWrapper struct {
 type collectionDetailsWrapper struct {
 	Details CollectionDetails `json:"collection"`
 }
+
+type patchCollectionWrapper struct {
+	Collection PatchCollectionRequest `json:"collection"`
+}
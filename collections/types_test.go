@@ -0,0 +1,203 @@
+// Package collections provides types/client for making requests to /collections.
+package collections
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestCollectionDetails_RoundTrip(t *testing.T) {
+	want, err := os.ReadFile("testdata/collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var details CollectionDetails
+	if err = json.Unmarshal(want, &details); err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(details)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotDoc, wantDoc interface{}
+	if err = json.Unmarshal(got, &gotDoc); err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(want, &wantDoc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotDoc, wantDoc) {
+		t.Errorf("json.Marshal() got = %s, want %s", got, want)
+	}
+
+	folder := details.Items[0]
+	if !folder.IsFolder() || folder.Auth.Attributes()[0].Value != "{{token}}" {
+		t.Errorf("Items[0] got = %+v, want folder with bearer auth", folder)
+	}
+	request := folder.Items[0].Request
+	if request.URL.Host[0] != "{{host}}" || request.URL.Variables[0].Key != "team" {
+		t.Errorf("Request.URL got = %+v, want url object", request.URL)
+	}
+	if request.Body.Mode != BodyModeRaw || request.Proxy.Port != 8080 {
+		t.Errorf("Request got = %+v, want raw body and proxy", request)
+	}
+	response := folder.Items[0].Responses[0]
+	if response.Code != 201 || response.OriginalRequest.URL.Raw != "https://{{host}}/users/core" {
+		t.Errorf("Responses[0] got = %+v, want typed saved response", response)
+	}
+	if details.Info.Description.Type != "text/markdown" {
+		t.Errorf("Info.Description got = %+v, want markdown description", details.Info.Description)
+	}
+	if len(details.Auth.Attributes()) != 2 || string(details.Auth.Extra["jwt"]) == "" {
+		t.Errorf("Auth got = %+v, want apikey attributes and preserved jwt", details.Auth)
+	}
+
+	extras := map[string]map[string]json.RawMessage{
+		"Info.Description": details.Info.Description.Extra,
+		"Info.Fork":        details.Info.Fork.Extra,
+		"AuthAttribute":    folder.Auth.Bearer[0].Extra,
+		"CertificateFile":  request.Certificate.Cert.Extra,
+		"Body.GraphQL":     details.Items[3].Request.Body.GraphQL.Extra,
+		"Body.File":        details.Items[4].Request.Body.File.Extra,
+	}
+	for name, extra := range extras {
+		if len(extra) != 1 {
+			t.Errorf("%s.Extra got = %v, want the unknown field", name, extra)
+		}
+	}
+	if responses := details.Items[2].Responses; responses == nil || len(responses) != 0 {
+		t.Errorf("Items[2].Responses got = %#v, want an empty slice", responses)
+	}
+}
+
+func TestItem_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Item
+	}{
+		{
+			name: "request as a url string",
+			data: `{"name":"ping","request":"https://example.com/ping"}`,
+			want: Item{Name: "ping", Request: &Request{URL: &URL{Raw: "https://example.com/ping"}}},
+		},
+		{
+			name: "host and path as strings",
+			data: `{"request":{"url":{"raw":"https://example.com/a/b","host":"example.com","path":"a/b"}}}`,
+			want: Item{Request: &Request{URL: &URL{
+				Raw:  "https://example.com/a/b",
+				Host: Segments{"example.com"},
+				Path: Segments{"a/b"},
+			}}},
+		},
+		{
+			name: "header string",
+			data: `{"request":{"header":"Accept: application/json\nX-Id: 1"}}`,
+			want: Item{Request: &Request{Headers: HeaderList{
+				{Key: "Accept", Value: "application/json"},
+				{Key: "X-Id", Value: "1"},
+			}}},
+		},
+		{
+			name: "script exec string",
+			data: `{"event":[{"listen":"test","script":{"exec":"a();\nb();"}}]}`,
+			want: Item{Events: []Event{{Listen: EventListenTest, Script: Script{Exec: Lines{"a();", "b();"}}}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Item
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("json.Unmarshal() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURL_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		url  URL
+		want string
+	}{
+		{
+			name: "raw only",
+			url:  URL{Raw: "https://example.com"},
+			want: `"https://example.com"`,
+		},
+		{
+			name: "object",
+			url:  URL{Raw: "https://example.com", Host: Segments{"example", "com"}},
+			want: `{"raw":"https://example.com","host":["example","com"]}`,
+		},
+		{
+			name: "extra fields",
+			url:  URL{Raw: "https://example.com", Extra: map[string]json.RawMessage{"custom": []byte(`1`)}},
+			want: `{"raw":"https://example.com","custom":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestModel_RoundTrip(t *testing.T) {
+	data := `{"id":"r1","name":"Get order","url":"{{baseUrl}}/orders/1","method":"GET",` +
+		`"headerData":[{"key":"Accept","value":"application/json","x-locked":true}],"dataMode":"raw",` +
+		`"rawModeData":"","graphqlModeData":{},"pathVariables":{"id":"1"}}`
+
+	var req RequestModel
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != "GET" || len(req.Headers) != 1 || len(req.Extra) != 2 {
+		t.Errorf("json.Unmarshal() got = %+v, want method, header and 2 extra fields", req)
+	}
+
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":"r1","name":"Get order","url":"{{baseUrl}}/orders/1","method":"GET",` +
+		`"headerData":[{"key":"Accept","value":"application/json","x-locked":true}],"dataMode":"raw",` +
+		`"graphqlModeData":{},"pathVariables":{"id":"1"}}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() got = %s, want %s", got, want)
+	}
+}
+
+func TestResponseModel_RoundTrip(t *testing.T) {
+	data := `{"id":"s1","name":"OK","responseCode":{"code":200,"name":"OK","detail":"Standard response"},` +
+		`"headers":[{"key":"Content-Type","value":"application/json","x-locked":true}],"cookies":[]}`
+
+	var res ResponseModel
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ResponseCode.Extra) != 1 || len(res.Headers[0].Extra) != 1 {
+		t.Errorf("json.Unmarshal() got = %+v, want extra response code and header fields", res)
+	}
+
+	got, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != data {
+		t.Errorf("json.Marshal() got = %s, want %s", got, data)
+	}
+}
@@ -0,0 +1,206 @@
+// Package imports provides types/client for making requests to /import.
+package imports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/actatum/postman-client/apisecurity"
+	"github.com/actatum/postman-client/rest"
+)
+
+const path = "/import"
+
+// maxDefinitionSize caps the size of definitions downloaded by OpenAPIURL.
+const maxDefinitionSize = 32 << 20
+
+// Client handles import operations.
+type Client struct {
+	restClient *rest.Client
+}
+
+// NewClient returns a new instance of Client.
+func NewClient(restClient *rest.Client) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// DetectLanguage returns the language of the given openapi definition,
+// apisecurity.LanguageJSON or apisecurity.LanguageYAML.
+func DetectLanguage(definition []byte) string {
+	trimmed := bytes.TrimSpace(definition)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return apisecurity.LanguageJSON
+	}
+	return apisecurity.LanguageYAML
+}
+
+// OpenAPI sends a POST request to /import/openapi, creating a collection from the given
+// openapi definition in json or yaml.
+func (c *Client) OpenAPI(
+	ctx context.Context,
+	definition []byte,
+	opts ...rest.RequestOption,
+) (Result, error) {
+	req := importRequest{Type: typeString, Input: string(definition)}
+	if DetectLanguage(definition) == apisecurity.LanguageJSON {
+		req = importRequest{Type: typeJSON, Input: json.RawMessage(bytes.TrimSpace(definition))}
+	}
+
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/openapi", c.restClient.BaseURL(), path),
+		req,
+	)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var response Result
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// OpenAPIFile sends a POST request to /import/openapi, creating a collection from the openapi
+// definition in the given file.
+func (c *Client) OpenAPIFile(
+	ctx context.Context,
+	name string,
+	opts ...rest.RequestOption,
+) (Result, error) {
+	definition, err := os.ReadFile(name)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return c.OpenAPI(ctx, definition, opts...)
+}
+
+// OpenAPIURL downloads the openapi definition at the given url, then sends a POST request to
+// /import/openapi creating a collection from it. The definition is downloaded with the http client
+// of the rest client, but without the api key, and must not exceed 32 MiB.
+func (c *Client) OpenAPIURL(
+	ctx context.Context,
+	url string,
+	opts ...rest.RequestOption,
+) (Result, error) {
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Result{}, err
+	}
+
+	resp, err := c.restClient.HTTPClient().Do(r)
+	if err != nil {
+		return Result{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return Result{}, fmt.Errorf("downloading openapi definition from %s: %s", url, resp.Status)
+	}
+	definition, err := io.ReadAll(io.LimitReader(resp.Body, maxDefinitionSize+1))
+	if err != nil {
+		return Result{}, err
+	}
+	if len(definition) > maxDefinitionSize {
+		return Result{}, fmt.Errorf("downloading openapi definition from %s: larger than %d bytes", url, maxDefinitionSize)
+	}
+
+	return c.OpenAPI(ctx, definition, opts...)
+}
+
+// Exported sends a POST request to /import/exported, importing the given postman data dump.
+func (c *Client) Exported(
+	ctx context.Context,
+	dump []byte,
+	opts ...rest.RequestOption,
+) (Result, error) {
+	return c.exportedFile(ctx, "postman_export.json", dump, opts)
+}
+
+// ExportedFile sends a POST request to /import/exported, importing the postman data dump in the given file.
+func (c *Client) ExportedFile(
+	ctx context.Context,
+	name string,
+	opts ...rest.RequestOption,
+) (Result, error) {
+	dump, err := os.ReadFile(name)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return c.exportedFile(ctx, filepath.Base(name), dump, opts)
+}
+
+// ExportedURL sends a POST request to /import/exported, importing the postman data dump at the given url.
+func (c *Client) ExportedURL(
+	ctx context.Context,
+	url string,
+	opts ...rest.RequestOption,
+) (Result, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/exported", c.restClient.BaseURL(), path),
+		importRequest{Type: typeURL, Input: url},
+	)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var response Result
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// exportedFile uploads the data dump as a multipart form, the only encoding /import/exported accepts for files.
+func (c *Client) exportedFile(
+	ctx context.Context,
+	filename string,
+	dump []byte,
+	opts []rest.RequestOption,
+) (Result, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	if err := mw.WriteField("type", typeFile); err != nil {
+		return Result{}, err
+	}
+	fw, err := mw.CreateFormFile("input", filename)
+	if err != nil {
+		return Result{}, err
+	}
+	if _, err = fw.Write(dump); err != nil {
+		return Result{}, err
+	}
+	if err = mw.Close(); err != nil {
+		return Result{}, err
+	}
+
+	r, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/exported", c.restClient.BaseURL(), path),
+		bytes.NewReader(body.Bytes()),
+	)
+	if err != nil {
+		return Result{}, err
+	}
+
+	opts = append(append([]rest.RequestOption{}, opts...), rest.WithContentType(mw.FormDataContentType()))
+
+	var response Result
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
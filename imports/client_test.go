@@ -0,0 +1,188 @@
+// Package imports provides types/client for making requests to /import.
+package imports
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/actatum/postman-client/apisecurity"
+	"github.com/actatum/postman-client/rest"
+	"github.com/actatum/postman-client/testdata"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       string
+	}{
+		{
+			name:       "json",
+			definition: testdata.APISecurityValidationSchemaJSON,
+			want:       apisecurity.LanguageJSON,
+		},
+		{
+			name:       "yaml",
+			definition: testdata.APISecurityValidationSchemaYAML,
+			want:       apisecurity.LanguageYAML,
+		},
+		{
+			name:       "yaml flow mapping",
+			definition: "{openapi: 3.0.0}",
+			want:       apisecurity.LanguageYAML,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectLanguage([]byte(tt.definition)); got != tt.want {
+				t.Errorf("DetectLanguage() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// importServer records the type and input of import requests and responds with a created collection.
+func importServer(t *testing.T) (*httptest.Server, *[]importRequest) {
+	t.Helper()
+
+	var requests []importRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req importRequest
+		switch r.URL.Path {
+		case "/import/openapi", "/import/exported":
+		case "/openapi.yaml":
+			_, _ = io.WriteString(w, testdata.APISecurityValidationSchemaYAML)
+			return
+		case "/large.yaml":
+			_, _ = io.CopyN(w, zeros{}, maxDefinitionSize+1)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Header.Get("Content-Type") == "application/json" {
+			var body struct {
+				Type  string          `json:"type"`
+				Input json.RawMessage `json:"input"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			req = importRequest{Type: body.Type, Input: string(body.Input)}
+		} else {
+			f, _, err := r.FormFile("input")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(f)
+			req = importRequest{Type: r.FormValue("type"), Input: string(b)}
+		}
+		requests = append(requests, req)
+
+		_ = json.NewEncoder(w).Encode(Result{
+			Collections: []Entity{{ID: "1", Name: "Imported", UID: "123-1"}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+type countingTransport struct {
+	n int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.n++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClient_OpenAPI(t *testing.T) {
+	srv, requests := importServer(t)
+	transport := &countingTransport{}
+	c := NewClient(rest.NewClient(
+		"key",
+		rest.WithBaseURL(srv.URL),
+		rest.WithHTTPClient(&http.Client{Transport: transport}),
+	))
+	ctx := context.Background()
+
+	res, err := c.OpenAPI(ctx, []byte(testdata.APISecurityValidationSchemaJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Collections) != 1 || res.Collections[0].UID != "123-1" {
+		t.Errorf("OpenAPI() got = %+v, want created collection", res)
+	}
+
+	file := filepath.Join(t.TempDir(), "openapi.yaml")
+	if err = os.WriteFile(file, []byte(testdata.APISecurityValidationSchemaYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.OpenAPIFile(ctx, file); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.OpenAPIURL(ctx, srv.URL+"/openapi.yaml"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.OpenAPIURL(ctx, srv.URL+"/missing.yaml"); err == nil {
+		t.Error("OpenAPIURL() error got = nil, want download error")
+	}
+	if _, err = c.OpenAPIURL(ctx, srv.URL+"/large.yaml"); err == nil {
+		t.Error("OpenAPIURL() error got = nil, want error for a definition over the size limit")
+	}
+	if transport.n != 6 {
+		t.Errorf("http client requests got = %v, want 6", transport.n)
+	}
+
+	wantTypes := []string{typeJSON, typeString, typeString}
+	if len(*requests) != len(wantTypes) {
+		t.Fatalf("len(requests) got = %v, want %v", len(*requests), len(wantTypes))
+	}
+	for i, req := range *requests {
+		if req.Type != wantTypes[i] {
+			t.Errorf("requests[%d].Type got = %v, want %v", i, req.Type, wantTypes[i])
+		}
+	}
+}
+
+func TestClient_Exported(t *testing.T) {
+	srv, requests := importServer(t)
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	ctx := context.Background()
+
+	dump := `{"version":1,"collections":[]}`
+	if _, err := c.Exported(ctx, []byte(dump)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ExportedURL(ctx, "https://example.com/backup.json"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []importRequest{
+		{Type: typeFile, Input: dump},
+		{Type: typeURL, Input: `"https://example.com/backup.json"`},
+	}
+	if len(*requests) != len(want) {
+		t.Fatalf("len(requests) got = %v, want %v", len(*requests), len(want))
+	}
+	for i, req := range *requests {
+		if req != want[i] {
+			t.Errorf("requests[%d] got = %+v, want %+v", i, req, want[i])
+		}
+	}
+}
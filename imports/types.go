@@ -0,0 +1,28 @@
+// Package imports provides types/client for making requests to /import.
+package imports
+
+// Possible values for import types.
+const (
+	typeJSON   = "json"
+	typeString = "string"
+	typeFile   = "file"
+	typeURL    = "url"
+)
+
+// Result is the response type for /import operations.
+type Result struct {
+	Collections  []Entity `json:"collections"`
+	Environments []Entity `json:"environments,omitempty"`
+}
+
+// Entity is a collection or environment created by an import.
+type Entity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	UID  string `json:"uid"`
+}
+
+type importRequest struct {
+	Type  string      `json:"type"`
+	Input interface{} `json:"input"`
+}
@@ -0,0 +1,113 @@
+// Package redact provides helpers for scrubbing secrets from postman api traffic.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Placeholder replaces redacted values.
+const Placeholder = "[REDACTED]"
+
+// secretValueType is the postman variable type whose values are always redacted.
+const secretValueType = "secret"
+
+// DefaultPaths are json paths holding secrets in postman api documents, other than values of type secret.
+var DefaultPaths = []string{
+	"webhook.webhookUrl",
+}
+
+// SensitiveHeaders are the headers redacted by Headers.
+var SensitiveHeaders = []string{
+	"X-Api-Key",
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// Headers returns a copy of h with the values of SensitiveHeaders replaced by Placeholder.
+func Headers(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range SensitiveHeaders {
+		if _, ok := out[http.CanonicalHeaderKey(name)]; ok {
+			out.Set(name, Placeholder)
+		}
+	}
+	return out
+}
+
+// JSON returns a copy of the json document with secrets replaced by Placeholder.
+//
+// The value of every object whose "type" is "secret" (e.g. environment values) is redacted,
+// along with the values found at the given paths. Paths are dot separated object keys, where "*"
+// matches any key and arrays are traversed implicitly, e.g. "environment.values.value".
+// Documents which are not valid json are returned unchanged.
+func JSON(body []byte, paths ...string) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return body
+	}
+
+	doc = secrets(doc)
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		doc = path(doc, strings.Split(p, "."))
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// secrets redacts the value of every object with a secret type.
+func secrets(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if typ, ok := t["type"].(string); ok && typ == secretValueType {
+			if _, ok = t["value"]; ok {
+				t["value"] = Placeholder
+			}
+		}
+		for k, child := range t {
+			t[k] = secrets(child)
+		}
+	case []interface{}:
+		for i, child := range t {
+			t[i] = secrets(child)
+		}
+	}
+	return v
+}
+
+// path redacts the values found at the given path segments.
+func path(v interface{}, segments []string) interface{} {
+	if len(segments) == 0 {
+		return Placeholder
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if segments[0] == "*" || segments[0] == k {
+				t[k] = path(child, segments[1:])
+			}
+		}
+	case []interface{}:
+		for i, child := range t {
+			t[i] = path(child, segments)
+		}
+	}
+	return v
+}
@@ -0,0 +1,84 @@
+// Package redact provides helpers for scrubbing secrets from postman api traffic.
+package redact
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestHeaders(t *testing.T) {
+	h := http.Header{
+		"X-Api-Key":    []string{"PMAK-123"},
+		"Content-Type": []string{"application/json"},
+	}
+
+	got := Headers(h)
+	want := http.Header{
+		"X-Api-Key":    []string{Placeholder},
+		"Content-Type": []string{"application/json"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Headers() got = %v, want %v", got, want)
+	}
+	if h.Get("X-Api-Key") != "PMAK-123" {
+		t.Errorf("Headers() modified the original header")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		paths []string
+		want  string
+	}{
+		{
+			name: "secret environment values",
+			body: `{"environment":{"values":[{"key":"apiKey","value":"PMAK-123","type":"secret"},` +
+				`{"key":"host","value":"example.com","type":"default"}]}}`,
+			want: `{"environment":{"values":[{"key":"apiKey","type":"secret","value":"[REDACTED]"},` +
+				`{"key":"host","type":"default","value":"example.com"}]}}`,
+		},
+		{
+			name:  "configured path",
+			body:  `{"webhook":{"id":"1","webhookUrl":"https://newman-api.getpostman.com/run/123"}}`,
+			paths: []string{"webhook.webhookUrl"},
+			want:  `{"webhook":{"id":"1","webhookUrl":"[REDACTED]"}}`,
+		},
+		{
+			name:  "wildcard path through arrays",
+			body:  `{"environments":[{"values":[{"key":"a","value":"1"},{"key":"b","value":"2"}]}]}`,
+			paths: []string{"*.values.value"},
+			want:  `{"environments":[{"values":[{"key":"a","value":"[REDACTED]"},{"key":"b","value":"[REDACTED]"}]}]}`,
+		},
+		{
+			name:  "missing path",
+			body:  `{"collection":{"id":"1"}}`,
+			paths: []string{"webhook.webhookUrl"},
+			want:  `{"collection":{"id":"1"}}`,
+		},
+		{
+			name: "large numbers are preserved",
+			body: `{"id":12345678901234567890}`,
+			want: `{"id":12345678901234567890}`,
+		},
+		{
+			name: "not json",
+			body: `<html>oops</html>`,
+			want: `<html>oops</html>`,
+		},
+		{
+			name: "empty",
+			body: ``,
+			want: ``,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(JSON([]byte(tt.body), tt.paths...)); got != tt.want {
+				t.Errorf("JSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+// Package mocks provides types/client for making requests to /mocks.
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+const path = "/mocks"
+
+// Client handles mock operations.
+type Client struct {
+	restClient *rest.Client
+}
+
+// NewClient returns a new instance of Client.
+func NewClient(restClient *rest.Client) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// Create sends a POST request to /mocks.
+func (c *Client) Create(
+	ctx context.Context,
+	mock Mock,
+	opts ...rest.RequestOption,
+) (Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s", c.restClient.BaseURL(), path),
+		mockWrapper{Mock: mock},
+	)
+	if err != nil {
+		return Mock{}, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mock, err
+}
+
+// Get sends a GET request to /mocks/:id.
+func (c *Client) Get(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		nil,
+	)
+	if err != nil {
+		return Mock{}, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mock, err
+}
+
+// GetAll sends a GET request to /mocks.
+func (c *Client) GetAll(
+	ctx context.Context,
+	opts ...rest.RequestOption,
+) ([]Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s", c.restClient.BaseURL(), path),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mocks, err
+}
+
+// Update sends a PUT request to /mocks/:id.
+func (c *Client) Update(
+	ctx context.Context,
+	id string,
+	mock Mock,
+	opts ...rest.RequestOption,
+) (Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		mockWrapper{Mock: mock},
+	)
+	if err != nil {
+		return Mock{}, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mock, err
+}
+
+// Delete sends a DELETE request to /mocks/:id.
+func (c *Client) Delete(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		nil,
+	)
+	if err != nil {
+		return Mock{}, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mock, err
+}
+
+// Publish sends a POST request to /mocks/:id/publish, making the mock server public.
+func (c *Client) Publish(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/%s/publish", c.restClient.BaseURL(), path, id),
+		nil,
+	)
+	if err != nil {
+		return Mock{}, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mock, err
+}
+
+// Unpublish sends a DELETE request to /mocks/:id/unpublish, making the mock server private.
+func (c *Client) Unpublish(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Mock, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s/unpublish", c.restClient.BaseURL(), path, id),
+		nil,
+	)
+	if err != nil {
+		return Mock{}, err
+	}
+
+	var response mockWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Mock, err
+}
+
+// CreateServerResponse sends a POST request to /mocks/:id/server-responses.
+func (c *Client) CreateServerResponse(
+	ctx context.Context,
+	mockID string,
+	serverResponse ServerResponse,
+	opts ...rest.RequestOption,
+) (ServerResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/%s/server-responses", c.restClient.BaseURL(), path, mockID),
+		serverResponseWrapper{ServerResponse: serverResponse},
+	)
+	if err != nil {
+		return ServerResponse{}, err
+	}
+
+	var response ServerResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetServerResponse sends a GET request to /mocks/:id/server-responses/:serverResponseId.
+func (c *Client) GetServerResponse(
+	ctx context.Context,
+	mockID string,
+	serverResponseID string,
+	opts ...rest.RequestOption,
+) (ServerResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s/server-responses/%s", c.restClient.BaseURL(), path, mockID, serverResponseID),
+		nil,
+	)
+	if err != nil {
+		return ServerResponse{}, err
+	}
+
+	var response ServerResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetAllServerResponses sends a GET request to /mocks/:id/server-responses.
+func (c *Client) GetAllServerResponses(
+	ctx context.Context,
+	mockID string,
+	opts ...rest.RequestOption,
+) ([]ServerResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s/server-responses", c.restClient.BaseURL(), path, mockID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []ServerResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// UpdateServerResponse sends a PUT request to /mocks/:id/server-responses/:serverResponseId.
+func (c *Client) UpdateServerResponse(
+	ctx context.Context,
+	mockID string,
+	serverResponseID string,
+	serverResponse ServerResponse,
+	opts ...rest.RequestOption,
+) (ServerResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/%s/server-responses/%s", c.restClient.BaseURL(), path, mockID, serverResponseID),
+		serverResponseWrapper{ServerResponse: serverResponse},
+	)
+	if err != nil {
+		return ServerResponse{}, err
+	}
+
+	var response ServerResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// DeleteServerResponse sends a DELETE request to /mocks/:id/server-responses/:serverResponseId.
+func (c *Client) DeleteServerResponse(
+	ctx context.Context,
+	mockID string,
+	serverResponseID string,
+	opts ...rest.RequestOption,
+) (ServerResponse, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s/server-responses/%s", c.restClient.BaseURL(), path, mockID, serverResponseID),
+		nil,
+	)
+	if err != nil {
+		return ServerResponse{}, err
+	}
+
+	var response ServerResponse
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetCallLogs sends a GET request to /mocks/:id/call-logs.
+// Set req.Cursor to the Meta.NextCursor of a response to get the next set of results.
+func (c *Client) GetCallLogs(
+	ctx context.Context,
+	mockID string,
+	req GetCallLogsRequest,
+	opts ...rest.RequestOption,
+) (CallLogs, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s/call-logs", c.restClient.BaseURL(), path, mockID),
+		nil,
+	)
+	if err != nil {
+		return CallLogs{}, err
+	}
+	q := r.URL.Query()
+	if req.Limit != nil {
+		q.Add("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Cursor != nil {
+		q.Add("cursor", *req.Cursor)
+	}
+	if req.Until != nil {
+		q.Add("until", *req.Until)
+	}
+	if req.Since != nil {
+		q.Add("since", *req.Since)
+	}
+	if req.ResponseStatusCode != nil {
+		q.Add("responseStatusCode", strconv.Itoa(*req.ResponseStatusCode))
+	}
+	if req.ResponseType != nil {
+		q.Add("responseType", *req.ResponseType)
+	}
+	if req.RequestMethod != nil {
+		q.Add("requestMethod", *req.RequestMethod)
+	}
+	if req.RequestPath != nil {
+		q.Add("requestPath", *req.RequestPath)
+	}
+	if req.Sort != nil {
+		q.Add("sort", *req.Sort)
+	}
+	if req.Direction != nil {
+		q.Add("direction", *req.Direction)
+	}
+	if req.Include != nil {
+		q.Add("include", *req.Include)
+	}
+	r.URL.RawQuery = q.Encode()
+
+	var response CallLogs
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetAllCallLogs sends GET requests to /mocks/:id/call-logs, following the cursors until every
+// call log matching req is returned.
+func (c *Client) GetAllCallLogs(
+	ctx context.Context,
+	mockID string,
+	req GetCallLogsRequest,
+	opts ...rest.RequestOption,
+) ([]CallLog, error) {
+	var logs []CallLog
+	for {
+		page, err := c.GetCallLogs(ctx, mockID, req, opts...)
+		if err != nil {
+			return logs, err
+		}
+		logs = append(logs, page.CallLogs...)
+
+		if page.Meta.NextCursor == "" || len(page.CallLogs) == 0 {
+			return logs, nil
+		}
+		cursor := page.Meta.NextCursor
+		req.Cursor = &cursor
+	}
+}
@@ -0,0 +1,119 @@
+// Package mocks_test tests the mocks client against the postmantest fake server.
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/mocks"
+	"github.com/actatum/postman-client/postmantest"
+	"github.com/actatum/postman-client/rest"
+)
+
+func TestClient_Mocks(t *testing.T) {
+	t.Parallel()
+
+	srv := postmantest.NewServer()
+	t.Cleanup(srv.Close)
+	rc := srv.RestClient()
+	c := mocks.NewClient(rc)
+
+	t.Run("full lifecycle", func(t *testing.T) {
+		ctx := context.Background()
+
+		collection, err := collections.NewClient(rc).Create(ctx, collections.CollectionDetails{
+			Info: collections.Info{Name: "Mocked"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Create failure (bad request)
+		_, err = c.Create(ctx, mocks.Mock{Name: "Test Mock"})
+		if err == nil {
+			t.Fatalf("expected error, got %v", err)
+		}
+
+		// Create success
+		mock, err := c.Create(ctx, mocks.Mock{
+			Name:       "Test Mock",
+			Collection: collection.UID,
+			Private:    true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Get Not Found
+		_, err = c.Get(ctx, "123")
+		if !errors.Is(err, rest.ErrNotFound) {
+			t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+		}
+
+		// Get recently created mock
+		mock, err = c.Get(ctx, mock.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// GetAll
+		_, err = c.GetAll(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Update
+		_, err = c.Update(ctx, mock.ID, mocks.Mock{Name: "Test Mock Updated", Private: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Publish and unpublish
+		if _, err = c.Publish(ctx, mock.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.Unpublish(ctx, mock.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		// Server responses
+		sr, err := c.CreateServerResponse(ctx, mock.ID, mocks.ServerResponse{
+			Name:       "Internal Server Error",
+			StatusCode: http.StatusInternalServerError,
+			Headers:    []mocks.Header{{Key: "Content-Type", Value: "application/json"}},
+			Language:   "json",
+			Body:       `{"error":"internal"}`,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetServerResponse(ctx, mock.ID, sr.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetAllServerResponses(ctx, mock.ID); err != nil {
+			t.Fatal(err)
+		}
+		sr.Name = "Service Unavailable"
+		sr.StatusCode = http.StatusServiceUnavailable
+		if _, err = c.UpdateServerResponse(ctx, mock.ID, sr.ID, sr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.DeleteServerResponse(ctx, mock.ID, sr.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		// Call logs
+		if _, err = c.GetAllCallLogs(ctx, mock.ID, mocks.GetCallLogsRequest{}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Delete
+		_, err = c.Delete(ctx, mock.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+// Package mocks provides types/client for making requests to /mocks.
+package mocks
+
+import "time"
+
+// Possible values for mock delay types.
+const (
+	DelayTypeFixed = "fixed"
+)
+
+// Possible values for mock delay presets.
+const (
+	DelayPresetMobile2G   = "1"
+	DelayPresetMobile3G   = "2"
+	DelayPresetCustomized = "3"
+)
+
+// Possible values for call log sort directions.
+const (
+	DirectionAscending  = "asc"
+	DirectionDescending = "desc"
+)
+
+// Mock ...
+type Mock struct {
+	ID          string    `json:"id,omitempty"`
+	Owner       string    `json:"owner,omitempty"`
+	UID         string    `json:"uid,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Collection  string    `json:"collection,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+	MockURL     string    `json:"mockUrl,omitempty"`
+	Config      *Config   `json:"config,omitempty"`
+	Private     bool      `json:"private,omitempty"`
+	IsPublic    bool      `json:"isPublic,omitempty"`
+	Deactivated bool      `json:"deactivated,omitempty"`
+	CreatedAt   time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt,omitempty"`
+}
+
+// Config ...
+type Config struct {
+	Headers          []string `json:"headers,omitempty"`
+	MatchBody        bool     `json:"matchBody,omitempty"`
+	MatchQueryParams bool     `json:"matchQueryParams,omitempty"`
+	MatchWildcards   bool     `json:"matchWildcards,omitempty"`
+	Delay            *Delay   `json:"delay,omitempty"`
+	ServerResponseID string   `json:"serverResponseId,omitempty"`
+}
+
+// Delay ...
+type Delay struct {
+	Type     string `json:"type,omitempty"`
+	Preset   string `json:"preset,omitempty"`
+	Duration int    `json:"duration,omitempty"`
+}
+
+// ServerResponse is a response returned by a mock server for every call when it is active,
+// e.g. to simulate 5xx errors.
+type ServerResponse struct {
+	ID         string    `json:"id,omitempty"`
+	Name       string    `json:"name,omitempty"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Headers    []Header  `json:"headers,omitempty"`
+	Language   string    `json:"language,omitempty"`
+	Body       string    `json:"body,omitempty"`
+	CreatedBy  string    `json:"createdBy,omitempty"`
+	UpdatedBy  string    `json:"updatedBy,omitempty"`
+	CreatedAt  time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt,omitempty"`
+}
+
+// Header ...
+type Header struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// GetCallLogsRequest is the request type for GET /mocks/:id/call-logs.
+type GetCallLogsRequest struct {
+	// The maximum number of rows to return in the response, up to a maximum value of 100.
+	Limit *int
+	// The pointer to the first record of the set of paginated results.
+	Cursor *string
+	// Return only results created until this given time, in YYYY-MM-DDTHH:MM:SS format.
+	Until *string
+	// Return only results created since the given time, in YYYY-MM-DDTHH:MM:SS format.
+	Since *string
+	// Return only call logs that match the given HTTP response status code.
+	ResponseStatusCode *int
+	// Return only call logs that match the given response type, e.g. 'success' or 'error'.
+	ResponseType *string
+	// Return only call logs that match the given HTTP method.
+	RequestMethod *string
+	// Return only call logs that match the given request path.
+	RequestPath *string
+	// Sort the results by the given value, e.g. 'servedAt'.
+	Sort *string
+	// Sort in ascending ('asc') or descending ('desc') order.
+	Direction *string
+	// Include the given data in the response, e.g. 'request.headers,response.body'.
+	Include *string
+}
+
+// CallLogs is the response type for GET /mocks/:id/call-logs.
+type CallLogs struct {
+	CallLogs []CallLog `json:"call-logs"`
+	Meta     Meta      `json:"meta"`
+}
+
+// Meta ...
+type Meta struct {
+	// The cursor to get the next set of results, empty when there are no more results.
+	NextCursor string `json:"nextCursor"`
+}
+
+// CallLog ...
+type CallLog struct {
+	ID           string          `json:"id"`
+	ResponseName string          `json:"responseName"`
+	ServedAt     time.Time       `json:"servedAt"`
+	Request      CallLogRequest  `json:"request"`
+	Response     CallLogResponse `json:"response"`
+}
+
+// CallLogRequest ...
+type CallLogRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Headers []Header    `json:"headers,omitempty"`
+	Body    CallLogBody `json:"body,omitempty"`
+}
+
+// CallLogResponse ...
+type CallLogResponse struct {
+	Type       string      `json:"type"`
+	StatusCode int         `json:"statusCode"`
+	Headers    []Header    `json:"headers,omitempty"`
+	Body       CallLogBody `json:"body,omitempty"`
+}
+
+// CallLogBody ...
+type CallLogBody struct {
+	Mode string `json:"mode,omitempty"`
+	Data string `json:"data,omitempty"`
+}
+
+type mockWrapper struct {
+	Mock  Mock   `json:"mock,omitempty"`
+	Mocks []Mock `json:"mocks,omitempty"`
+}
+
+type serverResponseWrapper struct {
+	ServerResponse ServerResponse `json:"serverResponse"`
+}
@@ -150,7 +150,7 @@ func (c *Client) RunMonitor(
 	}
 
 	var response runWrapper
-	err = c.restClient.DoRequest(r, &response)
+	err = c.restClient.DoRequest(r, &response, opts...)
 
 	return response.Run, err
 }
@@ -49,15 +49,68 @@ type Schedule struct {
 
 // Run ...
 type Run struct {
-	Status     string    `json:"status,omitempty"`
-	StartedAt  time.Time `json:"startedAt,omitempty"`
-	FinishedAt time.Time `json:"finishedAt,omitempty"`
+	Status     string         `json:"status,omitempty"`
+	StartedAt  time.Time      `json:"startedAt,omitempty"`
+	FinishedAt time.Time      `json:"finishedAt,omitempty"`
+	Info       RunInfo        `json:"info,omitempty"`
+	Stats      Stats          `json:"stats,omitempty"`
+	Executions []RunExecution `json:"executions,omitempty"`
+	Failures   []RunFailure   `json:"failures,omitempty"`
+}
+
+// RunInfo ...
+type RunInfo struct {
+	JobID          string    `json:"jobId,omitempty"`
+	MonitorID      string    `json:"monitorId,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	CollectionUID  string    `json:"collectionUid,omitempty"`
+	EnvironmentUID string    `json:"environmentUid,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	StartedAt      time.Time `json:"startedAt,omitempty"`
+	FinishedAt     time.Time `json:"finishedAt,omitempty"`
+}
+
+// RunExecution is the execution of a request of the collection during a run.
+type RunExecution struct {
+	ID       int         `json:"id,omitempty"`
+	Item     RunItem     `json:"item,omitempty"`
+	Request  RunRequest  `json:"request,omitempty"`
+	Response RunResponse `json:"response,omitempty"`
+}
+
+// RunItem ...
+type RunItem struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RunRequest ...
+type RunRequest struct {
+	Method    string    `json:"method,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	Timestamp time.Time `json:"timestamp,omitempty"`
+}
+
+// RunResponse ...
+type RunResponse struct {
+	Code         int `json:"code,omitempty"`
+	ResponseTime int `json:"responseTime,omitempty"` // In milliseconds.
+	ResponseSize int `json:"responseSize,omitempty"`
+}
+
+// RunFailure is a failed assertion or request of a run. Assertion maps the names of the
+// assertions of the execution to whether they passed.
+type RunFailure struct {
+	ExecutionID int             `json:"executionId,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Message     string          `json:"message,omitempty"`
+	Assertion   map[string]bool `json:"assertion,omitempty"`
 }
 
 // Stats ...
 type Stats struct {
 	Assertions Assertions `json:"assertions,omitempty"`
-	Requests   Requests   `json:"total,omitempty"`
+	Requests   Requests   `json:"requests,omitempty"`
 }
 
 // Assertions ...
@@ -68,7 +121,8 @@ type Assertions struct {
 
 // Requests ...
 type Requests struct {
-	Total int `json:"total,omitempty"`
+	Total  int `json:"total,omitempty"`
+	Failed int `json:"failed,omitempty"`
 }
 
 type monitorWrapper struct {
@@ -3,13 +3,26 @@ package postman
 
 import (
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
+	"time"
+
+	"github.com/actatum/postman-client/rest"
 )
 
 type options struct {
-	httpClient *http.Client
-	debugLog   io.Writer
+	httpClient  *http.Client
+	baseURL     string
+	userAgent   string
+	headers     http.Header
+	debugLog    io.Writer
+	logger      *slog.Logger
+	redactPaths []string
+	retryPolicy rest.RetryPolicy
+	rateLimit   rateLimitOption
+	middleware  []rest.Middleware
+	callMW      []rest.Middleware
 }
 
 // Option represents functional options for configuring the client.
@@ -45,3 +58,117 @@ func (d debugLogOption) apply(opts *options) {
 func WithDebugLog(w io.Writer) Option {
 	return debugLogOption{w: w}
 }
+
+type retryPolicyOption struct {
+	p rest.RetryPolicy
+}
+
+func (r retryPolicyOption) apply(opts *options) {
+	opts.retryPolicy = r.p
+}
+
+// WithRetryPolicy configures the client to retry transient failures according to the given policy.
+func WithRetryPolicy(p rest.RetryPolicy) Option {
+	return retryPolicyOption{p: p}
+}
+
+type rateLimitOption struct {
+	n   int
+	per time.Duration
+}
+
+func (r rateLimitOption) apply(opts *options) {
+	opts.rateLimit = r
+}
+
+// WithRateLimit limits the client set to n requests per the given interval, e.g. WithRateLimit(300, time.Minute).
+// The limit is shared by all the endpoint clients in the set.
+func WithRateLimit(n int, per time.Duration) Option {
+	return rateLimitOption{n: n, per: per}
+}
+
+type middlewareOption []rest.Middleware
+
+func (m middlewareOption) apply(opts *options) {
+	opts.middleware = append(opts.middleware, m...)
+}
+
+// WithMiddleware adds middleware around every request sent by the client set.
+// Middleware runs in the order given, the first being the outermost.
+func WithMiddleware(mw ...rest.Middleware) Option {
+	return middlewareOption(mw)
+}
+
+type callMiddlewareOption []rest.Middleware
+
+func (m callMiddlewareOption) apply(opts *options) {
+	opts.callMW = append(opts.callMW, m...)
+}
+
+// WithCallMiddleware adds middleware around every call made by the client set, running once per
+// call rather than once per attempt. See rest.WithCallMiddleware.
+func WithCallMiddleware(mw ...rest.Middleware) Option {
+	return callMiddlewareOption(mw)
+}
+
+type loggerOption struct {
+	l *slog.Logger
+}
+
+func (l loggerOption) apply(opts *options) {
+	opts.logger = l.l
+}
+
+// WithLogger configures the structured logger for api requests. See rest.WithLogger.
+func WithLogger(l *slog.Logger) Option {
+	return loggerOption{l: l}
+}
+
+type redactedPathsOption []string
+
+func (r redactedPathsOption) apply(opts *options) {
+	opts.redactPaths = append(opts.redactPaths, r...)
+}
+
+// WithRedactedPaths configures additional json paths redacted from logged bodies. See rest.WithRedactedPaths.
+func WithRedactedPaths(paths ...string) Option {
+	return redactedPathsOption(paths)
+}
+
+type baseURLOption string
+
+func (b baseURLOption) apply(opts *options) {
+	opts.baseURL = string(b)
+}
+
+// WithBaseURL configures the client set to send requests to the given base url. See rest.WithBaseURL.
+func WithBaseURL(u string) Option {
+	return baseURLOption(u)
+}
+
+type userAgentOption string
+
+func (u userAgentOption) apply(opts *options) {
+	opts.userAgent = string(u)
+}
+
+// WithUserAgent prefixes the default postman-client-go/<version> User-Agent header with the given value.
+func WithUserAgent(ua string) Option {
+	return userAgentOption(ua)
+}
+
+type defaultHeadersOption http.Header
+
+func (d defaultHeadersOption) apply(opts *options) {
+	if opts.headers == nil {
+		opts.headers = make(http.Header, len(d))
+	}
+	for k, v := range d {
+		opts.headers[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+	}
+}
+
+// WithDefaultHeaders configures headers sent with every request. See rest.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return defaultHeadersOption(h)
+}
@@ -0,0 +1,47 @@
+// Package otel provides OpenTelemetry tracing and metrics instrumentation for the postman client.
+package otel
+
+import (
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option represents functional options for configuring the instrumentation.
+type Option interface {
+	apply(*options)
+}
+
+type tracerProviderOption struct {
+	tp trace.TracerProvider
+}
+
+func (t tracerProviderOption) apply(opts *options) {
+	if t.tp != nil {
+		opts.tracerProvider = t.tp
+	}
+}
+
+// WithTracerProvider configures the TracerProvider used to create spans.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return tracerProviderOption{tp: tp}
+}
+
+type meterProviderOption struct {
+	mp metric.MeterProvider
+}
+
+func (m meterProviderOption) apply(opts *options) {
+	if m.mp != nil {
+		opts.meterProvider = m.mp
+	}
+}
+
+// WithMeterProvider configures the MeterProvider used to record metrics.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return meterProviderOption{mp: mp}
+}
@@ -0,0 +1,186 @@
+// Package otel provides OpenTelemetry tracing and metrics instrumentation for the postman client.
+//
+// Instrumentation is installed as call middleware, so that a single span covers all the attempts of a call:
+//
+//	cs := postman.NewClientSet("api-key", postman.WithCallMiddleware(otel.Middleware()))
+package otel
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/rest"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/actatum/postman-client/otel"
+
+// Attribute keys set on spans and metrics. The workspace, attempts and retries are only set on spans.
+const (
+	ResourceKey   = attribute.Key("postman.resource")
+	OperationKey  = attribute.Key("postman.operation")
+	WorkspaceKey  = attribute.Key("postman.workspace")
+	MethodKey     = attribute.Key("http.request.method")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+	AttemptsKey   = attribute.Key("postman.attempts")
+	RetriesKey    = attribute.Key("postman.retries")
+	AttemptKey    = attribute.Key("postman.attempt")
+)
+
+// Possible values for the operation attribute.
+const (
+	OperationCreate = "Create"
+	OperationGet    = "Get"
+	OperationGetAll = "GetAll"
+	OperationUpdate = "Update"
+	OperationDelete = "Delete"
+	OperationRun    = "Run"
+)
+
+type instruments struct {
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// Middleware returns a rest.Middleware which emits a span per api call and records request count,
+// latency and error metrics. The global tracer and meter providers are used unless overridden by options.
+//
+// It is meant to be installed with rest.WithCallMiddleware (postman.WithCallMiddleware), in which case
+// each attempt of a retried call is added as an event of the call span.
+func Middleware(opts ...Option) rest.Middleware {
+	options := options{
+		tracerProvider: otelapi.GetTracerProvider(),
+		meterProvider:  otelapi.GetMeterProvider(),
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	meter := options.meterProvider.Meter(instrumentationName)
+	inst := instruments{
+		tracer: options.tracerProvider.Tracer(instrumentationName),
+	}
+	// Instrument creation only fails for invalid names, in which case a no-op instrument is returned.
+	inst.requests, _ = meter.Int64Counter(
+		"postman.client.requests",
+		metric.WithDescription("Number of requests sent to the postman api."),
+	)
+	inst.errors, _ = meter.Int64Counter(
+		"postman.client.errors",
+		metric.WithDescription("Number of requests to the postman api which failed or returned an error status."),
+	)
+	inst.duration, _ = meter.Float64Histogram(
+		"postman.client.duration",
+		metric.WithDescription("Duration of requests to the postman api."),
+		metric.WithUnit("s"),
+	)
+
+	return func(next rest.Doer) rest.Doer {
+		return rest.DoerFunc(func(r *http.Request) (*http.Response, error) {
+			return inst.do(next, r)
+		})
+	}
+}
+
+func (inst instruments) do(next rest.Doer, r *http.Request) (*http.Response, error) {
+	resource, operation := Operation(r.Method, r.URL.Path)
+	attrs := []attribute.KeyValue{
+		ResourceKey.String(resource),
+		OperationKey.String(operation),
+		MethodKey.String(r.Method),
+	}
+
+	ctx, span := inst.tracer.Start(
+		r.Context(),
+		"postman."+resource+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	// The workspace has unbounded cardinality, so it is only set on the span and kept off the metrics.
+	if workspace := r.URL.Query().Get("workspace"); workspace != "" {
+		span.SetAttributes(WorkspaceKey.String(workspace))
+	}
+
+	attempts := 0
+	ctx = rest.ObserveAttempts(ctx, func(a rest.Attempt) {
+		attempts = a.Number
+		event := []attribute.KeyValue{AttemptKey.Int(a.Number)}
+		if a.Err != nil {
+			event = append(event, attribute.String("error", a.Err.Error()))
+		} else {
+			event = append(event, StatusCodeKey.Int(a.StatusCode))
+		}
+		span.AddEvent("attempt", trace.WithAttributes(event...))
+	})
+
+	start := time.Now()
+	resp, err := next.Do(r.WithContext(ctx))
+	elapsed := time.Since(start).Seconds()
+
+	if attempts > 0 {
+		span.SetAttributes(AttemptsKey.Int(attempts), RetriesKey.Int(attempts-1))
+	}
+
+	failed := err != nil
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		status := StatusCodeKey.Int(resp.StatusCode)
+		attrs = append(attrs, status)
+		span.SetAttributes(status)
+		if resp.StatusCode >= 400 {
+			failed = true
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+
+	set := metric.WithAttributes(attrs...)
+	inst.requests.Add(ctx, 1, set)
+	inst.duration.Record(ctx, elapsed, set)
+	if failed {
+		inst.errors.Add(ctx, 1, set)
+	}
+
+	return resp, err
+}
+
+// Operation derives the postman resource (collections, environments, monitors...) and
+// operation (Create, Get, GetAll, Update, Delete, Run) from a request method and url path.
+func Operation(method, path string) (resource, operation string) {
+	segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+	if len(segments) == 0 {
+		return "unknown", method
+	}
+	resource = segments[0]
+	tail := segments[1:]
+
+	switch method {
+	case http.MethodGet:
+		if len(tail) == 0 && resource != "me" {
+			return resource, OperationGetAll
+		}
+		return resource, OperationGet
+	case http.MethodPost:
+		if len(tail) > 0 && tail[len(tail)-1] == "run" {
+			return resource, OperationRun
+		}
+		return resource, OperationCreate
+	case http.MethodPut, http.MethodPatch:
+		return resource, OperationUpdate
+	case http.MethodDelete:
+		return resource, OperationDelete
+	default:
+		return resource, method
+	}
+}
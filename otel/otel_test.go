@@ -0,0 +1,201 @@
+// Package otel provides OpenTelemetry tracing and metrics instrumentation for the postman client.
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/actatum/postman-client/rest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		opts          []rest.RequestOption
+		status        int
+		failures      int
+		wantSpan      string
+		wantStatus    codes.Code
+		wantWorkspace string
+		wantAttempts  int64
+		wantErrors    int64
+	}{
+		{
+			name:         "get collection",
+			method:       http.MethodGet,
+			path:         "/collections/123",
+			status:       http.StatusOK,
+			wantSpan:     "postman.collections.Get",
+			wantStatus:   codes.Unset,
+			wantAttempts: 1,
+		},
+		{
+			name:          "create monitor in workspace",
+			method:        http.MethodPost,
+			path:          "/monitors",
+			opts:          []rest.RequestOption{rest.WithWorkspace("ws-1")},
+			status:        http.StatusOK,
+			wantSpan:      "postman.monitors.Create",
+			wantStatus:    codes.Unset,
+			wantWorkspace: "ws-1",
+			wantAttempts:  1,
+		},
+		{
+			name:         "retried workspace",
+			method:       http.MethodGet,
+			path:         "/workspaces/123",
+			status:       http.StatusOK,
+			failures:     2,
+			wantSpan:     "postman.workspaces.Get",
+			wantStatus:   codes.Unset,
+			wantAttempts: 3,
+		},
+		{
+			name:         "environment not found",
+			method:       http.MethodDelete,
+			path:         "/environments/123",
+			status:       http.StatusNotFound,
+			wantSpan:     "postman.environments.Delete",
+			wantStatus:   codes.Error,
+			wantAttempts: 1,
+			wantErrors:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(tt.status)
+				if tt.status != http.StatusOK {
+					w.Write([]byte(`{"error":{"name":"instanceNotFoundError","message":"not found"}}`))
+					return
+				}
+				w.Write([]byte(`{}`))
+			})
+			srv := httptest.NewServer(h)
+			t.Cleanup(srv.Close)
+
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+			reader := sdkmetric.NewManualReader()
+			mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+			rc := rest.NewClient(
+				"api-key",
+				rest.WithHTTPClient(srv.Client()),
+				rest.WithRetryPolicy(rest.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}),
+				rest.WithCallMiddleware(Middleware(WithTracerProvider(tp), WithMeterProvider(mp))),
+			)
+			r, err := rc.NewRequest(context.Background(), tt.method, srv.URL+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = rc.DoRequest(r, nil, tt.opts...)
+
+			spans := sr.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("len(spans) got = %v, want %v", len(spans), 1)
+			}
+			span := spans[0]
+			if span.Name() != tt.wantSpan {
+				t.Errorf("span.Name() got = %v, want %v", span.Name(), tt.wantSpan)
+			}
+			if span.Status().Code != tt.wantStatus {
+				t.Errorf("span.Status() got = %v, want %v", span.Status().Code, tt.wantStatus)
+			}
+			attrs := attribute.NewSet(span.Attributes()...)
+			if v, _ := attrs.Value(StatusCodeKey); v.AsInt64() != int64(tt.status) {
+				t.Errorf("status code attribute got = %v, want %v", v.AsInt64(), tt.status)
+			}
+			if v, _ := attrs.Value(WorkspaceKey); v.AsString() != tt.wantWorkspace {
+				t.Errorf("workspace attribute got = %v, want %v", v.AsString(), tt.wantWorkspace)
+			}
+			if v, _ := attrs.Value(AttemptsKey); v.AsInt64() != tt.wantAttempts {
+				t.Errorf("attempts attribute got = %v, want %v", v.AsInt64(), tt.wantAttempts)
+			}
+			if v, _ := attrs.Value(RetriesKey); v.AsInt64() != tt.wantAttempts-1 {
+				t.Errorf("retries attribute got = %v, want %v", v.AsInt64(), tt.wantAttempts-1)
+			}
+			if len(span.Events()) != int(tt.wantAttempts) {
+				t.Errorf("len(span.Events()) got = %v, want %v", len(span.Events()), tt.wantAttempts)
+			}
+
+			var rm metricdata.ResourceMetrics
+			if err = reader.Collect(context.Background(), &rm); err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]int64{}
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					switch data := m.Data.(type) {
+					case metricdata.Sum[int64]:
+						for _, dp := range data.DataPoints {
+							got[m.Name] += dp.Value
+							if dp.Attributes.HasValue(WorkspaceKey) {
+								t.Errorf("%s has the workspace attribute", m.Name)
+							}
+						}
+					case metricdata.Histogram[float64]:
+						for _, dp := range data.DataPoints {
+							got[m.Name] += int64(dp.Count)
+						}
+					}
+				}
+			}
+			if got["postman.client.requests"] != 1 {
+				t.Errorf("postman.client.requests got = %v, want %v", got["postman.client.requests"], 1)
+			}
+			if got["postman.client.duration"] != 1 {
+				t.Errorf("postman.client.duration count got = %v, want %v", got["postman.client.duration"], 1)
+			}
+			if got["postman.client.errors"] != tt.wantErrors {
+				t.Errorf("postman.client.errors got = %v, want %v", got["postman.client.errors"], tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestOperation(t *testing.T) {
+	tests := []struct {
+		method        string
+		path          string
+		wantResource  string
+		wantOperation string
+	}{
+		{http.MethodPost, "/collections", "collections", OperationCreate},
+		{http.MethodGet, "/collections", "collections", OperationGetAll},
+		{http.MethodGet, "/collections/123", "collections", OperationGet},
+		{http.MethodPut, "/environments/123", "environments", OperationUpdate},
+		{http.MethodDelete, "/workspaces/123", "workspaces", OperationDelete},
+		{http.MethodPost, "/monitors/123/run", "monitors", OperationRun},
+		{http.MethodGet, "/me", "me", OperationGet},
+		{http.MethodGet, "/", "unknown", http.MethodGet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			resource, operation := Operation(tt.method, tt.path)
+			if resource != tt.wantResource || operation != tt.wantOperation {
+				t.Errorf(
+					"Operation() got = %v %v, want %v %v",
+					resource, operation, tt.wantResource, tt.wantOperation,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,1163 @@
+// Package postmantest provides an in-memory fake of the postman api for hermetic tests.
+package postmantest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/apisecurity"
+	"github.com/actatum/postman-client/auditlogs"
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/environments"
+	"github.com/actatum/postman-client/mocks"
+	"github.com/actatum/postman-client/monitors"
+	"github.com/actatum/postman-client/user"
+	"github.com/actatum/postman-client/webhooks"
+	"github.com/actatum/postman-client/workspaces"
+)
+
+type collectionEntry struct {
+	meta    collections.Collection
+	details collections.CollectionDetails
+}
+
+type environmentEntry = environments.Environment
+
+type monitorEntry = monitors.Monitor
+
+type workspaceEntry = workspaces.Workspace
+
+type webhookEntry = webhooks.Webhook
+
+// workspace returns the target workspace of the request, writing an error if it does not exist.
+func (s *Server) workspace(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := r.URL.Query().Get("workspace")
+	if id == "" {
+		return "", true
+	}
+	if _, ok := s.workspaces.get(id); !ok {
+		writeNotFound(w, "workspace")
+		return "", false
+	}
+	return id, true
+}
+
+func (s *Server) handleCollections(w http.ResponseWriter, r *http.Request, segments []string) {
+	workspace, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Collection collections.CollectionDetails `json:"collection"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		if body.Collection.Info.Name == "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", "Found 1 errors with the supplied collection.")
+			return
+		}
+		meta := s.createCollection(workspace, body.Collection, collections.Fork{})
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"collection": collections.Collection{ID: meta.ID, Name: meta.Name, UID: meta.UID},
+		})
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		entries := s.collections.list(workspace)
+		list := make([]collections.Collection, 0, len(entries))
+		for _, e := range entries {
+			list = append(list, e.meta)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"collections": list})
+	case len(segments) == 1 && segments[0] == "merge" && r.Method == http.MethodPost:
+		s.mergeCollections(w, r)
+	case len(segments) == 2 && segments[0] == "fork" && r.Method == http.MethodPost:
+		source, ok := s.collections.get(segments[1])
+		if !ok {
+			source, ok = s.collectionByUID(segments[1])
+		}
+		if !ok {
+			writeNotFound(w, "collection")
+			return
+		}
+		var body struct {
+			Label string `json:"label"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		if body.Label == "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", "label is a required property.")
+			return
+		}
+		meta := s.createCollection(workspace, source.value.details, collections.Fork{
+			Label:     body.Label,
+			CreatedAt: now(),
+			From:      source.value.meta.UID,
+		})
+		writeJSON(w, http.StatusOK, map[string]interface{}{"collection": meta})
+	case len(segments) == 1:
+		s.handleCollection(w, r, segments[0])
+	case len(segments) == 2 || len(segments) == 3:
+		s.handleCollectionItems(w, r, segments)
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func (s *Server) createCollection(
+	workspace string,
+	details collections.CollectionDetails,
+	fork collections.Fork,
+) collections.Collection {
+	id := newID()
+	t := now()
+	meta := collections.Collection{
+		ID:        id,
+		Name:      details.Info.Name,
+		Owner:     strconv.Itoa(s.user.ID),
+		CreatedAt: t,
+		UpdatedAt: t,
+		UID:       s.uid(id),
+		Fork:      fork,
+	}
+	details.Info.PostmanID = id
+	details.Info.UpdatedAt = &t
+	if details.Info.Schema == "" {
+		details.Info.Schema = collections.SchemaV21
+	}
+	if fork.From != "" {
+		details.Info.Fork = &fork
+	}
+	s.collections.put(id, workspace, collectionEntry{meta: meta, details: details})
+	return meta
+}
+
+func (s *Server) handleCollection(w http.ResponseWriter, r *http.Request, id string) {
+	e, ok := s.collections.get(id)
+	if !ok {
+		e, ok = s.collectionByUID(id)
+	}
+	if !ok {
+		writeNotFound(w, "collection")
+		return
+	}
+	id = e.value.meta.ID
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"collection": e.value.details})
+	case http.MethodPut:
+		var body struct {
+			Collection collections.CollectionDetails `json:"collection"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		if body.Collection.Info.Name == "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", "Found 1 errors with the supplied collection.")
+			return
+		}
+		updated := e.value
+		updated.meta.Name = body.Collection.Info.Name
+		updated.meta.UpdatedAt = now()
+		updated.details = body.Collection
+		updated.details.Info.PostmanID = id
+		updated.details.Info.UpdatedAt = &updated.meta.UpdatedAt
+		updated.details.Info.Fork = e.value.details.Info.Fork
+		s.collections.put(id, e.workspace, updated)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"collection": collections.Collection{ID: id, Name: updated.meta.Name, UID: updated.meta.UID},
+		})
+	case http.MethodPatch:
+		var body struct {
+			Collection collections.PatchCollectionRequest `json:"collection"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		updated := e.value
+		patch := body.Collection
+		if patch.Info != nil && patch.Info.Name != "" {
+			updated.meta.Name = patch.Info.Name
+			updated.details.Info.Name = patch.Info.Name
+		}
+		if patch.Info != nil && patch.Info.Description != nil {
+			updated.details.Info.Description = &collections.Description{Content: *patch.Info.Description}
+		}
+		if patch.Variables != nil {
+			updated.details.Variables = patch.Variables
+		}
+		if patch.Auth != nil {
+			updated.details.Auth = patch.Auth
+		}
+		if patch.Events != nil {
+			updated.details.Events = patch.Events
+		}
+		updated.meta.UpdatedAt = now()
+		updated.details.Info.UpdatedAt = &updated.meta.UpdatedAt
+		s.collections.put(id, e.workspace, updated)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"collection": collections.Collection{ID: id, Name: updated.meta.Name, UID: updated.meta.UID},
+		})
+	case http.MethodDelete:
+		s.collections.delete(id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"collection": collections.Collection{ID: id, UID: e.value.meta.UID},
+		})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+// handleCollectionItems serves the folder, request and response endpoints of a collection.
+// Items created through them are stored apart from the collection details.
+func (s *Server) handleCollectionItems(w http.ResponseWriter, r *http.Request, segments []string) {
+	collectionID, kind, itemID := segments[0], segments[1], ""
+	if len(segments) == 3 {
+		itemID = segments[2]
+	}
+	c, ok := s.collections.get(collectionID)
+	if !ok {
+		c, ok = s.collectionByUID(collectionID)
+	}
+	if !ok {
+		writeNotFound(w, "collection")
+		return
+	}
+	collectionID = c.value.meta.ID
+
+	owner := strconv.Itoa(s.user.ID)
+	switch kind {
+	case "folders":
+		serveModel(w, r, s, &s.folders, "folder", collectionID, itemID, func(id string, f *collections.Folder) string {
+			if f.Name == "" {
+				return "name is a required property."
+			}
+			if f.Folder != "" {
+				if e, ok := s.folders.get(f.Folder); !ok || e.workspace != collectionID {
+					return "folder does not exist."
+				}
+			}
+			t := now()
+			f.ID, f.Collection, f.Owner, f.CreatedAt, f.UpdatedAt = id, collectionID, owner, &t, &t
+			return ""
+		})
+	case "requests":
+		folderID := r.URL.Query().Get("folder")
+		serveModel(w, r, s, &s.requestItems, "request", collectionID, itemID,
+			func(id string, req *collections.RequestModel) string {
+				if folderID != "" {
+					if e, ok := s.folders.get(folderID); !ok || e.workspace != collectionID {
+						return "folder does not exist."
+					}
+				}
+				t := now()
+				req.ID, req.Collection, req.Folder, req.Owner = id, collectionID, folderID, owner
+				req.CreatedAt, req.UpdatedAt = &t, &t
+				return ""
+			})
+	case "responses":
+		requestID := r.URL.Query().Get("request")
+		serveModel(w, r, s, &s.responses, "response", collectionID, itemID,
+			func(id string, res *collections.ResponseModel) string {
+				if e, ok := s.requestItems.get(requestID); !ok || e.workspace != collectionID {
+					return "request does not exist."
+				}
+				t := now()
+				res.ID, res.Owner, res.CreatedAt, res.UpdatedAt = id, owner, &t, &t
+				return ""
+			})
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+// serveModel serves the item endpoints for one model. create validates and fills in a new model,
+// returning a validation message on failure.
+func serveModel[T any](
+	w http.ResponseWriter,
+	r *http.Request,
+	s *Server,
+	st *store[T],
+	model string,
+	collectionID string,
+	id string,
+	create func(id string, v *T) string,
+) {
+	respond := func(action, id string, v interface{}) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"meta":     map[string]string{"model": model, "action": action},
+			"model_id": id,
+			"revision": s.requests,
+			"data":     v,
+		})
+	}
+
+	if id == "" {
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w)
+			return
+		}
+		var v T
+		if !decode(w, r, &v) {
+			return
+		}
+		id = newID()
+		if msg := create(id, &v); msg != "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", msg)
+			return
+		}
+		st.put(id, collectionID, v)
+		respond("create", id, v)
+		return
+	}
+
+	e, ok := st.get(id)
+	if !ok || e.workspace != collectionID {
+		writeNotFound(w, model)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		respond("find", id, e.value)
+	case http.MethodPut:
+		// Update merges the sent fields over the stored model.
+		v := e.value
+		if !decode(w, r, &v) {
+			return
+		}
+		st.put(id, collectionID, v)
+		respond("update", id, v)
+	case http.MethodDelete:
+		st.delete(id)
+		respond("destroy", id, map[string]string{"id": id})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) mergeCollections(w http.ResponseWriter, r *http.Request) {
+	var req collections.MergeForkRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	source, ok := s.collectionByUID(req.Source)
+	if !ok {
+		writeNotFound(w, "collection")
+		return
+	}
+	destination, ok := s.collectionByUID(req.Destination)
+	if !ok {
+		writeNotFound(w, "collection")
+		return
+	}
+
+	switch req.Strategy {
+	case "", collections.MergeStrategyUpdateSourceWithDestination, collections.MergeStrategyDeleteSource:
+	default:
+		writeError(w, http.StatusBadRequest, "malformedRequestError", "strategy must be one of the allowed values.")
+		return
+	}
+
+	merged := destination.value
+	merged.details.Items = source.value.details.Items
+	merged.meta.UpdatedAt = now()
+	s.collections.put(merged.meta.ID, destination.workspace, merged)
+
+	if req.Strategy == collections.MergeStrategyDeleteSource {
+		s.collections.delete(source.value.meta.ID)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"collection": collections.Collection{ID: merged.meta.ID, UID: merged.meta.UID},
+	})
+}
+
+// collectionByUID looks a collection up by uid, falling back to its id.
+func (s *Server) collectionByUID(uid string) (*entry[collectionEntry], bool) {
+	for _, e := range s.collections.items {
+		if e.value.meta.UID == uid || e.value.meta.ID == uid {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) handleEnvironments(w http.ResponseWriter, r *http.Request, segments []string) {
+	workspace, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Environment environments.Environment `json:"environment"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		if body.Environment.Name == "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", "Missing required property: name")
+			return
+		}
+		id := newID()
+		t := now()
+		env := body.Environment
+		env.ID = id
+		env.UID = s.uid(id)
+		env.Owner = strconv.Itoa(s.user.ID)
+		env.CreatedAt = t
+		env.UpdatedAt = t
+		s.environments.put(id, workspace, env)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"environment": environments.Environment{ID: id, Name: env.Name, UID: env.UID},
+		})
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		list := s.environments.list(workspace)
+		for i := range list {
+			list[i].Values = nil
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"environments": list})
+	case len(segments) == 1:
+		s.handleEnvironment(w, r, segments[0])
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func (s *Server) handleEnvironment(w http.ResponseWriter, r *http.Request, id string) {
+	e, ok := s.environments.get(id)
+	if !ok {
+		e, ok = s.environmentByUID(id)
+	}
+	if !ok {
+		writeNotFound(w, "environment")
+		return
+	}
+	id = e.value.ID
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"environment": e.value})
+	case http.MethodPut:
+		var body struct {
+			Environment environments.Environment `json:"environment"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		env := e.value
+		if body.Environment.Name != "" {
+			env.Name = body.Environment.Name
+		}
+		env.Values = body.Environment.Values
+		env.UpdatedAt = now()
+		s.environments.put(id, e.workspace, env)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"environment": environments.Environment{ID: id, Name: env.Name, UID: env.UID},
+		})
+	case http.MethodDelete:
+		s.environments.delete(id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"environment": environments.Environment{ID: id, UID: e.value.UID},
+		})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+// mockEntry holds a mock server along with its server responses and call logs.
+type mockEntry struct {
+	mock            mocks.Mock
+	serverResponses store[mocks.ServerResponse]
+	callLogs        []mocks.CallLog
+}
+
+func (s *Server) handleMocks(w http.ResponseWriter, r *http.Request, segments []string) {
+	workspace, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Mock mocks.Mock `json:"mock"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		m := body.Mock
+		if m.Collection == "" {
+			writeError(w, http.StatusBadRequest, "paramMissingError", "Parameter is missing in the request.")
+			return
+		}
+		c, ok := s.collectionByUID(m.Collection)
+		if !ok {
+			writeNotFound(w, "collection")
+			return
+		}
+		id := newID()
+		t := now()
+		m.ID = id
+		m.UID = s.uid(id)
+		m.Owner = strconv.Itoa(s.user.ID)
+		m.Collection = c.value.meta.UID
+		m.MockURL = fmt.Sprintf("https://%s.mock.pstmn.io", id)
+		m.IsPublic = !m.Private
+		m.Private = false
+		m.CreatedAt = t
+		m.UpdatedAt = t
+		if m.Config == nil {
+			m.Config = &mocks.Config{}
+		}
+		s.mocks.put(id, workspace, &mockEntry{mock: m})
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mock": m})
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		entries := s.mocks.list(workspace)
+		list := make([]mocks.Mock, 0, len(entries))
+		for _, e := range entries {
+			list = append(list, e.mock)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mocks": list})
+	case len(segments) >= 1:
+		e, ok := s.mocks.get(segments[0])
+		if !ok {
+			writeNotFound(w, "mock")
+			return
+		}
+		s.handleMock(w, r, e.value, segments[1:])
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func (s *Server) handleMock(w http.ResponseWriter, r *http.Request, e *mockEntry, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mock": e.mock})
+	case len(segments) == 0 && r.Method == http.MethodPut:
+		var body struct {
+			Mock mocks.Mock `json:"mock"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		if body.Mock.Name != "" {
+			e.mock.Name = body.Mock.Name
+		}
+		if body.Mock.Description != "" {
+			e.mock.Description = body.Mock.Description
+		}
+		if body.Mock.Environment != "" {
+			e.mock.Environment = body.Mock.Environment
+		}
+		if body.Mock.Config != nil {
+			e.mock.Config = body.Mock.Config
+		}
+		e.mock.IsPublic = !body.Mock.Private
+		e.mock.UpdatedAt = now()
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mock": e.mock})
+	case len(segments) == 0 && r.Method == http.MethodDelete:
+		s.mocks.delete(e.mock.ID)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"mock": mocks.Mock{ID: e.mock.ID, UID: e.mock.UID},
+		})
+	case len(segments) == 1 && segments[0] == "publish" && r.Method == http.MethodPost:
+		e.mock.IsPublic = true
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mock": mocks.Mock{ID: e.mock.ID}})
+	case len(segments) == 1 && segments[0] == "unpublish" && r.Method == http.MethodDelete:
+		e.mock.IsPublic = false
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mock": mocks.Mock{ID: e.mock.ID}})
+	case len(segments) >= 1 && segments[0] == "server-responses":
+		s.handleServerResponses(w, r, e, segments[1:])
+	case len(segments) == 1 && segments[0] == "call-logs" && r.Method == http.MethodGet:
+		handleCallLogs(w, r, e)
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func (s *Server) handleServerResponses(w http.ResponseWriter, r *http.Request, e *mockEntry, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodPost:
+			var body struct {
+				ServerResponse mocks.ServerResponse `json:"serverResponse"`
+			}
+			if !decode(w, r, &body) {
+				return
+			}
+			sr := body.ServerResponse
+			if sr.Name == "" || sr.StatusCode == 0 {
+				writeError(w, http.StatusBadRequest, "paramMissingError", "Parameter is missing in the request.")
+				return
+			}
+			t := now()
+			sr.ID = newID()
+			sr.CreatedBy = strconv.Itoa(s.user.ID)
+			sr.UpdatedBy = sr.CreatedBy
+			sr.CreatedAt = t
+			sr.UpdatedAt = t
+			e.serverResponses.put(sr.ID, "", sr)
+			writeJSON(w, http.StatusOK, sr)
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, e.serverResponses.list(""))
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	if len(segments) != 1 {
+		writeRouteNotFound(w)
+		return
+	}
+
+	sr, ok := e.serverResponses.get(segments[0])
+	if !ok {
+		writeNotFound(w, "server response")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, sr.value)
+	case http.MethodPut:
+		var body struct {
+			ServerResponse mocks.ServerResponse `json:"serverResponse"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		updated := sr.value
+		if body.ServerResponse.Name != "" {
+			updated.Name = body.ServerResponse.Name
+		}
+		if body.ServerResponse.StatusCode != 0 {
+			updated.StatusCode = body.ServerResponse.StatusCode
+		}
+		if body.ServerResponse.Headers != nil {
+			updated.Headers = body.ServerResponse.Headers
+		}
+		if body.ServerResponse.Language != "" {
+			updated.Language = body.ServerResponse.Language
+		}
+		if body.ServerResponse.Body != "" {
+			updated.Body = body.ServerResponse.Body
+		}
+		updated.UpdatedBy = strconv.Itoa(s.user.ID)
+		updated.UpdatedAt = now()
+		e.serverResponses.put(updated.ID, "", updated)
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		e.serverResponses.delete(sr.value.ID)
+		writeJSON(w, http.StatusOK, sr.value)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func handleCallLogs(w http.ResponseWriter, r *http.Request, e *mockEntry) {
+	q := r.URL.Query()
+	limit := 100
+	if v := q.Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 || limit > 100 {
+			writeError(w, http.StatusBadRequest, "invalidParamsError", "limit must be between 1 and 100.")
+			return
+		}
+	}
+	cursor := 0
+	if v := q.Get("cursor"); v != "" {
+		var err error
+		if cursor, err = strconv.Atoi(v); err != nil || cursor < 0 {
+			writeError(w, http.StatusBadRequest, "invalidParamsError", "cursor is invalid.")
+			return
+		}
+	}
+
+	logs := make([]mocks.CallLog, 0, len(e.callLogs))
+	for _, l := range e.callLogs {
+		if v := q.Get("responseStatusCode"); v != "" && v != strconv.Itoa(l.Response.StatusCode) {
+			continue
+		}
+		if v := q.Get("requestMethod"); v != "" && !strings.EqualFold(v, l.Request.Method) {
+			continue
+		}
+		if v := q.Get("requestPath"); v != "" && v != l.Request.Path {
+			continue
+		}
+		logs = append(logs, l)
+	}
+	ascending := strings.EqualFold(q.Get("direction"), mocks.DirectionAscending)
+	sort.SliceStable(logs, func(i, j int) bool {
+		if ascending {
+			return logs[i].ServedAt.Before(logs[j].ServedAt)
+		}
+		return logs[i].ServedAt.After(logs[j].ServedAt)
+	})
+
+	if cursor > len(logs) {
+		cursor = len(logs)
+	}
+	end := cursor + limit
+	if end > len(logs) {
+		end = len(logs)
+	}
+
+	meta := mocks.Meta{}
+	if end < len(logs) {
+		meta.NextCursor = strconv.Itoa(end)
+	}
+	writeJSON(w, http.StatusOK, mocks.CallLogs{CallLogs: logs[cursor:end], Meta: meta})
+}
+
+func (s *Server) handleMonitors(w http.ResponseWriter, r *http.Request, segments []string) {
+	workspace, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Monitor monitors.Monitor `json:"monitor"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		m := body.Monitor
+		if msg := s.validateMonitor(m); msg != "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", msg)
+			return
+		}
+		id := newID()
+		m.ID = id
+		m.UID = s.uid(id)
+		m.Owner = s.user.ID
+		if c, ok := s.collectionByUID(m.Collection); ok {
+			m.CollectionUID = c.value.meta.UID
+		}
+		if env, ok := s.environmentByUID(m.Environment); ok {
+			m.EnvironmentUID = env.value.UID
+		}
+		s.monitors.put(id, workspace, m)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"monitor": monitors.Monitor{ID: id, Name: m.Name, UID: m.UID},
+		})
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"monitors": s.monitors.list(workspace)})
+	case len(segments) == 2 && segments[1] == "run" && r.Method == http.MethodPost:
+		e, ok := s.monitors.get(segments[0])
+		if !ok {
+			writeNotFound(w, "monitor")
+			return
+		}
+		t := now()
+		m := e.value
+		run := monitors.Run{
+			Status:     "success",
+			StartedAt:  t,
+			FinishedAt: t,
+			Info: monitors.RunInfo{
+				JobID:          newID(),
+				MonitorID:      m.ID,
+				Name:           m.Name,
+				CollectionUID:  m.CollectionUID,
+				EnvironmentUID: m.EnvironmentUID,
+				Status:         "success",
+				StartedAt:      t,
+				FinishedAt:     t,
+			},
+		}
+		m.LastRun = run
+		s.monitors.put(m.ID, e.workspace, m)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"run": run})
+	case len(segments) == 1:
+		s.handleMonitor(w, r, segments[0])
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func (s *Server) validateMonitor(m monitors.Monitor) string {
+	switch {
+	case m.Name == "":
+		return "Missing required property: name"
+	case m.Collection == "":
+		return "Missing required property: collection"
+	case m.Schedule.Cron == "":
+		return "Missing required property: schedule.cron"
+	}
+	if _, ok := s.collectionByUID(m.Collection); !ok {
+		return "The specified collection does not exist."
+	}
+	return ""
+}
+
+// environmentByUID looks an environment up by uid, falling back to its id.
+func (s *Server) environmentByUID(uid string) (*entry[environmentEntry], bool) {
+	for _, e := range s.environments.items {
+		if e.value.UID == uid || e.value.ID == uid {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) handleMonitor(w http.ResponseWriter, r *http.Request, id string) {
+	e, ok := s.monitors.get(id)
+	if !ok {
+		writeNotFound(w, "monitor")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"monitor": e.value})
+	case http.MethodPut:
+		var body struct {
+			Monitor monitors.Monitor `json:"monitor"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		m := e.value
+		if body.Monitor.Name != "" {
+			m.Name = body.Monitor.Name
+		}
+		if body.Monitor.Schedule.Cron != "" {
+			m.Schedule = body.Monitor.Schedule
+		}
+		s.monitors.put(id, e.workspace, m)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"monitor": monitors.Monitor{ID: id, Name: m.Name, UID: m.UID},
+		})
+	case http.MethodDelete:
+		s.monitors.delete(id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"monitor": monitors.Monitor{ID: id, UID: e.value.UID},
+		})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleWorkspaces(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Workspace workspaces.Workspace `json:"workspace"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		ws := body.Workspace
+		if msg := validateWorkspace(ws); msg != "" {
+			writeError(w, http.StatusBadRequest, "malformedRequestError", msg)
+			return
+		}
+		id := newID()
+		t := now()
+		ws.ID = id
+		ws.Visibility = ws.Type
+		ws.CreatedBy = strconv.Itoa(s.user.ID)
+		ws.UpdatedBy = ws.CreatedBy
+		ws.CreatedAt = t
+		ws.UpdatedAt = t
+		s.workspaces.put(id, "", ws)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"workspace": workspaces.Workspace{ID: id, Name: ws.Name},
+		})
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		typ := r.URL.Query().Get("type")
+		list := make([]workspaces.Workspace, 0)
+		for _, ws := range s.workspaces.list("") {
+			if typ == "" || ws.Type == typ {
+				list = append(list, workspaces.Workspace{
+					ID:         ws.ID,
+					Name:       ws.Name,
+					Type:       ws.Type,
+					Visibility: ws.Visibility,
+				})
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"workspaces": list})
+	case len(segments) == 1:
+		s.handleWorkspace(w, r, segments[0])
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func validateWorkspace(ws workspaces.Workspace) string {
+	switch {
+	case ws.Name == "":
+		return "Missing required property: name"
+	case ws.Type != workspaces.WorkspaceTypePersonal && ws.Type != workspaces.WorkspaceTypeTeam:
+		return "type must be one of personal, team"
+	default:
+		return ""
+	}
+}
+
+func (s *Server) handleWorkspace(w http.ResponseWriter, r *http.Request, id string) {
+	e, ok := s.workspaces.get(id)
+	if !ok {
+		writeNotFound(w, "workspace")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"workspace": s.workspaceContents(e.value)})
+	case http.MethodPut:
+		var body struct {
+			Workspace workspaces.Workspace `json:"workspace"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		ws := e.value
+		if body.Workspace.Name != "" {
+			ws.Name = body.Workspace.Name
+		}
+		if body.Workspace.Type != "" {
+			ws.Type = body.Workspace.Type
+			ws.Visibility = ws.Type
+		}
+		ws.Description = body.Workspace.Description
+		ws.UpdatedAt = now()
+		s.workspaces.put(id, "", ws)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"workspace": workspaces.Workspace{ID: id, Name: ws.Name},
+		})
+	case http.MethodDelete:
+		s.workspaces.delete(id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"workspace": workspaces.Workspace{ID: id}})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+// workspaceContents lists the resources created in the workspace.
+func (s *Server) workspaceContents(ws workspaces.Workspace) workspaces.Workspace {
+	for _, c := range s.collections.list(ws.ID) {
+		ws.Collections = append(ws.Collections, workspaces.Collection{ID: c.meta.ID, Name: c.meta.Name, UID: c.meta.UID})
+	}
+	for _, env := range s.environments.list(ws.ID) {
+		ws.Environments = append(ws.Environments, workspaces.Environment{ID: env.ID, Name: env.Name, UID: env.UID})
+	}
+	for _, m := range s.monitors.list(ws.ID) {
+		ws.Monitors = append(ws.Monitors, workspaces.Monitor{ID: m.ID, Name: m.Name, UID: m.UID})
+	}
+	return ws
+}
+
+func (s *Server) handleWebhooks(w http.ResponseWriter, r *http.Request, segments []string) {
+	workspace, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Webhook webhooks.Webhook `json:"webhook"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		wh := body.Webhook
+		if wh.Name == "" {
+			writeError(w, http.StatusBadRequest, "validationError", "name cannot be empty.")
+			return
+		}
+		if _, ok := s.collectionByUID(wh.Collection); !ok {
+			writeError(w, http.StatusBadRequest, "validationError", "collection does not exist.")
+			return
+		}
+		id := newID()
+		wh.ID = id
+		wh.UID = s.uid(id)
+		wh.WebhookURL = fmt.Sprintf("https://newman-api.getpostman.com/run/%d/%s", s.user.ID, id)
+		s.webhooks.put(id, workspace, wh)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"webhook": wh})
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"webhooks": s.webhooks.list(workspace)})
+	case len(segments) == 1:
+		s.handleWebhook(w, r, segments[0])
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request, id string) {
+	e, ok := s.webhooks.get(id)
+	if !ok {
+		writeNotFound(w, "webhook")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"webhook": e.value})
+	case http.MethodPut:
+		var body struct {
+			Webhook webhooks.Webhook `json:"webhook"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		wh := e.value
+		if body.Webhook.Name != "" {
+			wh.Name = body.Webhook.Name
+		}
+		if body.Webhook.Collection != "" {
+			if _, ok := s.collectionByUID(body.Webhook.Collection); !ok {
+				writeError(w, http.StatusBadRequest, "validationError", "collection does not exist.")
+				return
+			}
+			wh.Collection = body.Webhook.Collection
+		}
+		s.webhooks.put(id, e.workspace, wh)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"webhook": wh})
+	case http.MethodDelete:
+		s.webhooks.delete(id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"webhook": webhooks.Webhook{ID: id, UID: e.value.UID}})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleMe(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"user": s.user,
+		"operations": []user.Operation{
+			{Name: "api_usage", Limit: 1000000, Usage: s.requests},
+		},
+	})
+}
+
+func (s *Server) handleAuditLogs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	q := r.URL.Query()
+	since, err := parseDate(q.Get("since"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidParamsError", "since must be a date in YYYY-MM-DD format.")
+		return
+	}
+	until, err := parseDate(q.Get("until"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidParamsError", "until must be a date in YYYY-MM-DD format.")
+		return
+	}
+	limit := 300
+	if v := q.Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 || limit > 300 {
+			writeError(w, http.StatusBadRequest, "invalidParamsError", "limit must be between 1 and 300.")
+			return
+		}
+	}
+	cursor := 0
+	if v := q.Get("cursor"); v != "" {
+		if cursor, err = strconv.Atoi(v); err != nil || cursor < 0 {
+			writeError(w, http.StatusBadRequest, "invalidParamsError", "cursor is invalid.")
+			return
+		}
+	}
+
+	trails := make([]auditlogs.Trail, 0, len(s.trails))
+	for _, t := range s.trails {
+		if !since.IsZero() && t.Timestamp.Before(since) {
+			continue
+		}
+		// until is inclusive of the whole day.
+		if !until.IsZero() && !t.Timestamp.Before(until.AddDate(0, 0, 1)) {
+			continue
+		}
+		trails = append(trails, t)
+	}
+	ascending := strings.EqualFold(q.Get("order_by"), "ASC")
+	sort.SliceStable(trails, func(i, j int) bool {
+		if ascending {
+			return trails[i].Timestamp.Before(trails[j].Timestamp)
+		}
+		return trails[i].Timestamp.After(trails[j].Timestamp)
+	})
+
+	if cursor > len(trails) {
+		cursor = len(trails)
+	}
+	end := cursor + limit
+	if end > len(trails) {
+		end = len(trails)
+	}
+
+	response := map[string]interface{}{"trails": trails[cursor:end]}
+	if end < len(trails) {
+		response["meta"] = map[string]int{"nextCursor": end}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func parseDate(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", v)
+}
+
+func (s *Server) handleAPIValidation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	var req apisecurity.ValidateAPISchemaRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	var name, reason string
+	switch {
+	case req.Schema.Type != apisecurity.OpenAPIV3 && req.Schema.Type != apisecurity.OpenAPIV2:
+		name, reason = "Invalid schema", "Provided schema type is not supported."
+	case req.Schema.Language != apisecurity.LanguageJSON && req.Schema.Language != apisecurity.LanguageYAML:
+		name, reason = "Invalid schema", "Provided schema language is not supported."
+	case !strings.Contains(req.Schema.Schema, "openapi") && !strings.Contains(req.Schema.Schema, "swagger"):
+		name, reason = "Invalid Schema", "Specification must contain a semantic version number of the OAS specification"
+	}
+	if name != "" {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error": map[string]interface{}{
+				"name": map[string]string{"name": name, "reason": reason},
+			},
+		})
+		return
+	}
+
+	warnings := s.warnings
+	if warnings == nil {
+		warnings = []apisecurity.Warning{}
+	}
+	writeJSON(w, http.StatusOK, apisecurity.ValidateAPISchemaResponse{Warnings: warnings})
+}
@@ -0,0 +1,309 @@
+// Package postmantest provides an in-memory fake of the postman api for hermetic tests.
+//
+// The fake implements /collections, /environments, /mocks, /monitors, /workspaces, /webhooks, /me,
+// /audit/logs and /security/api-validation, and returns error bodies shaped like the real api's.
+//
+//	srv := postmantest.NewServer()
+//	defer srv.Close()
+//
+//	cs := srv.ClientSet()
+//	collection, err := cs.Collections().Create(ctx, details)
+package postmantest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	postman "github.com/actatum/postman-client"
+	"github.com/actatum/postman-client/apisecurity"
+	"github.com/actatum/postman-client/auditlogs"
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/mocks"
+	"github.com/actatum/postman-client/rest"
+	"github.com/actatum/postman-client/user"
+)
+
+// APIKey is the api key accepted by the fake server. Requests with any other key are unauthorized.
+const APIKey = "PMAK-postmantest"
+
+// DefaultUser is the user returned by GET /me unless changed with Server.SetUser.
+var DefaultUser = user.User{
+	ID:       12345678,
+	Username: "postmantest",
+	Email:    "postmantest@example.com",
+	FullName: "Postman Test",
+	IsPublic: false,
+}
+
+// Server is an in-memory fake of the postman api served by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	user         user.User
+	requests     int
+	collections  store[collectionEntry]
+	folders      store[collections.Folder]        // Keyed by collection id instead of workspace.
+	requestItems store[collections.RequestModel]  // Keyed by collection id instead of workspace.
+	responses    store[collections.ResponseModel] // Keyed by collection id instead of workspace.
+	environments store[environmentEntry]
+	mocks        store[*mockEntry]
+	monitors     store[monitorEntry]
+	workspaces   store[workspaceEntry]
+	webhooks     store[webhookEntry]
+	trails       []auditlogs.Trail
+	warnings     []apisecurity.Warning
+	failures     []failure
+}
+
+type failure struct {
+	status int
+	err    rest.Error
+}
+
+// NewServer starts and returns a new fake postman api server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		user: DefaultUser,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// ClientSet returns a postman.ClientSet configured to send requests to the fake server.
+// Additional options are applied after the server's own.
+func (s *Server) ClientSet(opts ...postman.Option) *postman.ClientSet {
+	return postman.NewClientSet(APIKey, append(s.clientOptions(), opts...)...)
+}
+
+// RestClient returns a rest.Client configured to send requests to the fake server.
+// Additional options are applied after the server's own.
+func (s *Server) RestClient(opts ...rest.Option) *rest.Client {
+	return rest.NewClient(
+		APIKey,
+		append([]rest.Option{rest.WithBaseURL(s.URL), rest.WithHTTPClient(s.Client())}, opts...)...,
+	)
+}
+
+func (s *Server) clientOptions() []postman.Option {
+	return []postman.Option{
+		postman.WithBaseURL(s.URL),
+		postman.WithHTTPClient(s.Client()),
+	}
+}
+
+// SetUser sets the user returned by GET /me.
+func (s *Server) SetUser(u user.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.user = u
+}
+
+// AddTrails adds audit log trails returned by GET /audit/logs.
+func (s *Server) AddTrails(trails ...auditlogs.Trail) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.trails = append(s.trails, trails...)
+}
+
+// AddCallLogs adds call logs returned by GET /mocks/:id/call-logs for the given mock.
+// It does nothing if the mock does not exist.
+func (s *Server) AddCallLogs(mockID string, logs ...mocks.CallLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.mocks.get(mockID); ok {
+		e.value.callLogs = append(e.value.callLogs, logs...)
+	}
+}
+
+// SetAPIValidationWarnings sets the warnings returned for valid schemas by POST /security/api-validation.
+func (s *Server) SetAPIValidationWarnings(warnings ...apisecurity.Warning) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.warnings = warnings
+}
+
+// FailNext makes the next request fail with the given status and error, regardless of its endpoint.
+// Calls are queued, so FailNext can be used to fail several consecutive requests.
+func (s *Server) FailNext(status int, name, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, failure{status: status, err: rest.Error{Name: name, Message: message}})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Header.Get("X-Api-Key") != APIKey {
+		writeError(
+			w,
+			http.StatusUnauthorized,
+			"AuthenticationError",
+			"Invalid API Key. Every request requires a valid API Key to be sent.",
+		)
+		return
+	}
+	s.requests++
+
+	if len(s.failures) > 0 {
+		f := s.failures[0]
+		s.failures = s.failures[1:]
+		writeError(w, f.status, f.err.Name, f.err.Message)
+		return
+	}
+
+	segments := strings.FieldsFunc(r.URL.Path, func(r rune) bool { return r == '/' })
+	if len(segments) == 0 {
+		writeRouteNotFound(w)
+		return
+	}
+
+	switch {
+	case segments[0] == "collections":
+		s.handleCollections(w, r, segments[1:])
+	case segments[0] == "environments":
+		s.handleEnvironments(w, r, segments[1:])
+	case segments[0] == "mocks":
+		s.handleMocks(w, r, segments[1:])
+	case segments[0] == "monitors":
+		s.handleMonitors(w, r, segments[1:])
+	case segments[0] == "workspaces":
+		s.handleWorkspaces(w, r, segments[1:])
+	case segments[0] == "webhooks":
+		s.handleWebhooks(w, r, segments[1:])
+	case segments[0] == "me" && len(segments) == 1:
+		s.handleMe(w, r)
+	case segments[0] == "audit" && len(segments) == 2 && segments[1] == "logs":
+		s.handleAuditLogs(w, r)
+	case segments[0] == "security" && len(segments) == 2 && segments[1] == "api-validation":
+		s.handleAPIValidation(w, r)
+	default:
+		writeRouteNotFound(w)
+	}
+}
+
+// entry holds a stored value along with bookkeeping shared by every resource.
+type entry[T any] struct {
+	seq       int
+	workspace string
+	value     T
+}
+
+// store is an insertion ordered map of resources keyed by id.
+type store[T any] struct {
+	seq   int
+	items map[string]*entry[T]
+}
+
+func (s *store[T]) put(id, workspace string, v T) {
+	if s.items == nil {
+		s.items = make(map[string]*entry[T])
+	}
+	if e, ok := s.items[id]; ok {
+		e.value = v
+		return
+	}
+	s.seq++
+	s.items[id] = &entry[T]{seq: s.seq, workspace: workspace, value: v}
+}
+
+func (s *store[T]) get(id string) (*entry[T], bool) {
+	e, ok := s.items[id]
+	return e, ok
+}
+
+func (s *store[T]) delete(id string) bool {
+	if _, ok := s.items[id]; !ok {
+		return false
+	}
+	delete(s.items, id)
+	return true
+}
+
+// list returns the stored values in insertion order, filtered by workspace when one is given.
+func (s *store[T]) list(workspace string) []T {
+	entries := make([]*entry[T], 0, len(s.items))
+	for _, e := range s.items {
+		if workspace == "" || e.workspace == workspace {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].seq < entries[j].seq })
+
+	values := make([]T, 0, len(entries))
+	for _, e := range entries {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+// newID returns a random uuid formatted id.
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	h := hex.EncodeToString(b[:])
+	return fmt.Sprintf("%s-%s-%s-%s-%s", h[0:8], h[8:12], h[12:16], h[16:20], h[20:32])
+}
+
+func (s *Server) uid(id string) string {
+	return fmt.Sprintf("%d-%s", s.user.ID, id)
+}
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "malformedRequestError", "The request body is not valid JSON.")
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Request-Id", newID())
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, name, message string) {
+	writeJSON(w, status, map[string]map[string]string{
+		"error": {
+			"name":    name,
+			"message": message,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, resource string) {
+	writeError(
+		w,
+		http.StatusNotFound,
+		"instanceNotFoundError",
+		fmt.Sprintf("We could not find the %s you are looking for", resource),
+	)
+}
+
+func writeRouteNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "notFound", "Requested resource not found")
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "methodNotAllowed", "The requested method is not allowed")
+}
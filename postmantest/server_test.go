@@ -0,0 +1,589 @@
+// Package postmantest provides an in-memory fake of the postman api for hermetic tests.
+package postmantest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	postman "github.com/actatum/postman-client"
+	"github.com/actatum/postman-client/apisecurity"
+	"github.com/actatum/postman-client/auditlogs"
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/environments"
+	"github.com/actatum/postman-client/mocks"
+	"github.com/actatum/postman-client/monitors"
+	"github.com/actatum/postman-client/rest"
+	"github.com/actatum/postman-client/webhooks"
+	"github.com/actatum/postman-client/workspaces"
+)
+
+func TestServer_Unauthorized(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	cs := postman.NewClientSet("bad-key", postman.WithBaseURL(srv.URL), postman.WithHTTPClient(srv.Client()))
+	_, _, err := cs.Users().GetAuthenticatedUser(context.Background())
+	if !errors.Is(err, rest.ErrUnauthorized) {
+		t.Fatalf("GetAuthenticatedUser() error got = %v, want %v", err, rest.ErrUnauthorized)
+	}
+}
+
+func TestServer_FailNext(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	cs := srv.ClientSet()
+
+	srv.FailNext(http.StatusTooManyRequests, "rateLimited", "Rate limit exceeded.")
+	_, err := cs.Collections().GetAll(context.Background())
+	if !errors.Is(err, rest.ErrRateLimited) {
+		t.Fatalf("GetAll() error got = %v, want %v", err, rest.ErrRateLimited)
+	}
+
+	if _, err = cs.Collections().GetAll(context.Background()); err != nil {
+		t.Fatalf("GetAll() error got = %v, want nil", err)
+	}
+}
+
+func TestServer_Collections(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	c := srv.ClientSet().Collections()
+	ctx := context.Background()
+
+	if _, err := c.Create(ctx, collections.CollectionDetails{}); !errors.Is(err, rest.ErrValidation) {
+		t.Fatalf("Create() error got = %v, want %v", err, rest.ErrValidation)
+	}
+
+	details := collections.CollectionDetails{
+		Info: collections.Info{Name: "Test Collection"},
+		Items: []collections.Item{
+			{Name: "Get users", Request: &collections.Request{Method: http.MethodGet}},
+		},
+	}
+	created, err := c.Create(ctx, details)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Info.Name != "Test Collection" || len(got.Items) != 1 || got.Info.PostmanID != created.ID {
+		t.Fatalf("Get() got = %+v, want created collection", got)
+	}
+
+	if _, err = c.Get(ctx, "123"); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+
+	details.Info.Name = "Renamed"
+	if _, err = c.Update(ctx, created.ID, details); err != nil {
+		t.Fatal(err)
+	}
+
+	fork, err := c.CreateFork(ctx, created.ID, "my fork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fork.Fork.Label != "my fork" || fork.Fork.From != created.UID {
+		t.Fatalf("CreateFork() got = %+v, want fork of %v", fork, created.UID)
+	}
+
+	_, err = c.MergeFork(ctx, collections.MergeForkRequest{
+		Strategy:    collections.MergeStrategyDeleteSource,
+		Source:      fork.UID,
+		Destination: created.UID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := c.GetAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0].Name != "Renamed" {
+		t.Fatalf("GetAll() got = %+v, want the renamed collection only", all)
+	}
+
+	if _, err = c.Delete(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Get(ctx, created.ID); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+}
+
+func TestServer_ByUID(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	cs := srv.ClientSet()
+	ctx := context.Background()
+
+	created, err := cs.Collections().Create(ctx, collections.CollectionDetails{Info: collections.Info{Name: "Test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	details, err := cs.Collections().Get(ctx, created.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Info.PostmanID != created.ID {
+		t.Fatalf("Get() got = %+v, want collection %v", details.Info, created.ID)
+	}
+
+	details.Info.Name = "Renamed"
+	if _, err = cs.Collections().Update(ctx, created.UID, details); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := cs.Collections().Get(ctx, created.ID); got.Info.Name != "Renamed" {
+		t.Fatalf("Get() got = %v, want %v", got.Info.Name, "Renamed")
+	}
+	folder, err := cs.Collections().CreateFolder(ctx, created.UID, collections.Folder{Name: "Users"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cs.Collections().GetFolder(ctx, created.ID, folder.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cs.Collections().CreateFork(ctx, created.UID, "fork"); err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := cs.Environments().Create(ctx, environments.Environment{Name: "Staging"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotEnv, err := cs.Environments().Get(ctx, env.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotEnv.ID != env.ID {
+		t.Fatalf("Get() got = %+v, want environment %v", gotEnv, env.ID)
+	}
+	if _, err = cs.Environments().Delete(ctx, env.UID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cs.Environments().Get(ctx, env.ID); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+}
+
+func TestServer_Workspaces(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	cs := srv.ClientSet()
+	ctx := context.Background()
+
+	ws, err := cs.Workspaces().Create(ctx, workspaces.Workspace{
+		Name: "Test Workspace",
+		Type: workspaces.WorkspaceTypeTeam,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inWorkspace := rest.WithWorkspace(ws.ID)
+
+	env, err := cs.Environments().Create(ctx, environments.Environment{
+		Name: "Test Environment",
+		Values: []environments.EnvironmentValue{
+			{Key: "token", Value: "secret", Enabled: true, Type: environments.EnvironmentValueTypeSecret},
+		},
+	}, inWorkspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cs.Environments().Create(ctx, environments.Environment{Name: "Elsewhere"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := cs.Workspaces().Get(ctx, ws.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Environments) != 1 || got.Environments[0].ID != env.ID {
+		t.Fatalf("Get() environments got = %+v, want %v", got.Environments, env.ID)
+	}
+
+	envs, err := cs.Environments().GetAll(ctx, inWorkspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envs) != 1 {
+		t.Fatalf("len(GetAll()) got = %v, want %v", len(envs), 1)
+	}
+
+	typ := workspaces.WorkspaceTypePersonal
+	personal, err := cs.Workspaces().GetAll(ctx, workspaces.GetAllWorkspacesRequest{Type: &typ})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(personal) != 0 {
+		t.Fatalf("len(GetAll(personal)) got = %v, want %v", len(personal), 0)
+	}
+
+	if _, err = cs.Environments().GetAll(ctx, rest.WithWorkspace("missing")); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("GetAll() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+
+	if _, err = cs.Workspaces().Delete(ctx, ws.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_CollectionItems(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	c := srv.ClientSet().Collections()
+	ctx := context.Background()
+
+	collection, err := c.Create(ctx, collections.CollectionDetails{
+		Info: collections.Info{Name: "Items"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	description := "Patched description"
+	patched, err := c.Patch(ctx, collection.ID, collections.PatchCollectionRequest{
+		Info:      &collections.PatchInfo{Name: "Patched", Description: &description},
+		Variables: []collections.Variable{{Key: "baseUrl", Value: "https://example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patched.Name != "Patched" {
+		t.Fatalf("Patch() got = %+v, want name Patched", patched)
+	}
+	details, err := c.Get(ctx, collection.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Info.Description == nil || details.Info.Description.Content != description ||
+		len(details.Variables) != 1 {
+		t.Fatalf("Get() got = %+v, want patched description and variables", details)
+	}
+
+	if _, err = c.CreateFolder(ctx, collection.ID, collections.Folder{}); !errors.Is(err, rest.ErrValidation) {
+		t.Fatalf("CreateFolder() error got = %v, want %v", err, rest.ErrValidation)
+	}
+	folder, err := c.CreateFolder(ctx, collection.ID, collections.Folder{Name: "Orders"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder.ID == "" || folder.Collection != collection.ID {
+		t.Fatalf("CreateFolder() got = %+v, want id and collection", folder)
+	}
+
+	req, err := c.CreateRequest(ctx, collection.ID, folder.ID, collections.RequestModel{
+		Name:   "Get order",
+		Method: http.MethodGet,
+		URL:    "{{baseUrl}}/orders/1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Folder != folder.ID {
+		t.Fatalf("CreateRequest() folder got = %v, want %v", req.Folder, folder.ID)
+	}
+
+	updated, err := c.UpdateRequest(ctx, collection.ID, req.ID, collections.RequestModel{Method: http.MethodHead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Method != http.MethodHead || updated.Name != "Get order" {
+		t.Fatalf("UpdateRequest() got = %+v, want only the method changed", updated)
+	}
+
+	res, err := c.CreateResponse(ctx, collection.ID, req.ID, collections.ResponseModel{
+		Name:         "Found",
+		ResponseCode: &collections.ResponseCode{Code: http.StatusOK, Name: "OK"},
+		Text:         `{"id":1}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetResponse(ctx, collection.ID, res.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Text != `{"id":1}` || got.ResponseCode.Code != http.StatusOK {
+		t.Fatalf("GetResponse() got = %+v, want saved response", got)
+	}
+
+	if _, err = c.DeleteFolder(ctx, collection.ID, folder.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.GetFolder(ctx, collection.ID, folder.ID); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("GetFolder() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+}
+
+func TestServer_MonitorsAndWebhooks(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	cs := srv.ClientSet()
+	ctx := context.Background()
+
+	collection, err := cs.Collections().Create(ctx, collections.CollectionDetails{
+		Info: collections.Info{Name: "Monitored"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cs.Monitors().Create(ctx, monitors.Monitor{Name: "No collection"})
+	if !errors.Is(err, rest.ErrValidation) {
+		t.Fatalf("Create() error got = %v, want %v", err, rest.ErrValidation)
+	}
+
+	m, err := cs.Monitors().Create(ctx, monitors.Monitor{
+		Name:       "Nightly",
+		Collection: collection.UID,
+		Schedule:   monitors.Schedule{Cron: "0 0 * * *", Timezone: "UTC"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run, err := cs.Monitors().RunMonitor(ctx, m.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != "success" {
+		t.Fatalf("RunMonitor() status got = %v, want %v", run.Status, "success")
+	}
+
+	got, err := cs.Monitors().Get(ctx, m.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.CollectionUID != collection.UID || got.LastRun.Status != "success" {
+		t.Fatalf("Get() got = %+v, want last run and collection uid", got)
+	}
+
+	_, err = cs.Webhooks().Create(ctx, webhooks.Webhook{Collection: collection.UID})
+	var e *rest.Error
+	if !errors.As(err, &e) || e.Name != "validationError" || e.Message != "name cannot be empty." {
+		t.Fatalf("Create() error got = %v, want validationError", err)
+	}
+
+	wh, err := cs.Webhooks().Create(ctx, webhooks.Webhook{Name: "Hook", Collection: collection.UID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wh.WebhookURL == "" || wh.ID == "" {
+		t.Fatalf("Create() got = %+v, want webhook url and id", wh)
+	}
+
+	updated, err := cs.Webhooks().Update(ctx, wh.ID, webhooks.Webhook{Name: "Renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Renamed" || updated.Collection != collection.UID {
+		t.Fatalf("Update() got = %+v, want renamed webhook", updated)
+	}
+
+	all, err := cs.Webhooks().GetAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0].Name != "Renamed" {
+		t.Fatalf("GetAll() got = %+v, want 1 renamed webhook", all)
+	}
+
+	if _, err = cs.Webhooks().Delete(ctx, wh.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cs.Webhooks().Get(ctx, wh.ID); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+}
+
+func TestServer_Mocks(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	cs := srv.ClientSet()
+	ctx := context.Background()
+
+	collection, err := cs.Collections().Create(ctx, collections.CollectionDetails{
+		Info: collections.Info{Name: "Mocked"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := cs.Mocks()
+	m, err := c.Create(ctx, mocks.Mock{Name: "Feature branch", Collection: collection.UID, Private: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.MockURL == "" || m.IsPublic {
+		t.Fatalf("Create() got = %+v, want private mock with url", m)
+	}
+
+	if _, err = c.Publish(ctx, m.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := c.Get(ctx, m.ID); !got.IsPublic {
+		t.Fatalf("Get() IsPublic got = %v, want %v", got.IsPublic, true)
+	}
+
+	sr, err := c.CreateServerResponse(ctx, m.ID, mocks.ServerResponse{
+		Name:       "Internal Server Error",
+		StatusCode: http.StatusInternalServerError,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr, err = c.UpdateServerResponse(ctx, m.ID, sr.ID, mocks.ServerResponse{StatusCode: http.StatusBadGateway})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sr.StatusCode != http.StatusBadGateway || sr.Name != "Internal Server Error" {
+		t.Fatalf("UpdateServerResponse() got = %+v, want updated status code", sr)
+	}
+	if _, err = c.DeleteServerResponse(ctx, m.ID, sr.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.GetServerResponse(ctx, m.ID, sr.ID); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("GetServerResponse() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+
+	base := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		srv.AddCallLogs(m.ID, mocks.CallLog{
+			ID:       strconv.Itoa(i),
+			ServedAt: base.Add(time.Duration(i) * time.Minute),
+			Request:  mocks.CallLogRequest{Method: http.MethodGet, Path: "/users"},
+			Response: mocks.CallLogResponse{Type: "success", StatusCode: http.StatusOK},
+		})
+	}
+	limit := 2
+	page, err := c.GetCallLogs(ctx, m.ID, mocks.GetCallLogsRequest{Limit: &limit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.CallLogs) != 2 || page.CallLogs[0].ID != "4" || page.Meta.NextCursor == "" {
+		t.Fatalf("GetCallLogs() got = %+v, want newest 2 call logs and a cursor", page)
+	}
+	logs, err := c.GetAllCallLogs(ctx, m.ID, mocks.GetCallLogsRequest{Limit: &limit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 5 {
+		t.Fatalf("len(GetAllCallLogs()) got = %v, want %v", len(logs), 5)
+	}
+
+	if _, err = c.Delete(ctx, m.ID); err != nil {
+		t.Fatal(err)
+	}
+	all, err := c.GetAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 0 {
+		t.Fatalf("len(GetAll()) got = %v, want %v", len(all), 0)
+	}
+}
+
+func TestServer_User(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	u, ops, err := srv.ClientSet().Users().GetAuthenticatedUser(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != DefaultUser {
+		t.Fatalf("GetAuthenticatedUser() got = %+v, want %+v", u, DefaultUser)
+	}
+	if len(ops) != 1 || ops[0].Usage != 1 {
+		t.Fatalf("GetAuthenticatedUser() operations got = %+v, want usage of 1", ops)
+	}
+}
+
+func TestServer_AuditLogs(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	base := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		srv.AddTrails(auditlogs.Trail{
+			ID:        i + 1,
+			Action:    "user.login",
+			Timestamp: base.AddDate(0, 0, i),
+		})
+	}
+
+	since, until, limit, order := "2022-10-02", "2022-10-04", 2, "ASC"
+	logs, err := srv.ClientSet().AuditLogs().Get(context.Background(), auditlogs.GetAuditLogsRequest{
+		Since:   &since,
+		Until:   &until,
+		Limit:   &limit,
+		OrderBy: &order,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs.Trails) != 2 || logs.Trails[0].ID != 2 || logs.Trails[1].ID != 3 {
+		t.Fatalf("Get() got = %+v, want trails 2 and 3", logs.Trails)
+	}
+	if logs.Meta.NextCursor != "2" {
+		t.Fatalf("Get() next cursor got = %v, want %v", logs.Meta.NextCursor, "2")
+	}
+
+	limit = 1
+	it := srv.ClientSet().AuditLogs().Iter(context.Background(), auditlogs.GetAuditLogsRequest{
+		Since: &since,
+		Limit: &limit,
+	})
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Trail().ID)
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	if !reflect.DeepEqual(ids, []int{5, 4, 3, 2}) {
+		t.Fatalf("Iter() got = %v, want %v", ids, []int{5, 4, 3, 2})
+	}
+}
+
+func TestServer_APISecurity(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	srv.SetAPIValidationWarnings(apisecurity.Warning{Severity: "HIGH", Message: "Security field is not defined"})
+	c := srv.ClientSet().APISecurity()
+
+	warnings, err := c.ValidateAPISchema(context.Background(), apisecurity.ValidateAPISchemaRequest{
+		Schema: apisecurity.APISchema{
+			Type:     apisecurity.OpenAPIV3,
+			Language: apisecurity.LanguageJSON,
+			Schema:   `{"openapi":"3.0.0"}`,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("len(warnings) got = %v, want %v", len(warnings), 1)
+	}
+
+	_, err = c.ValidateAPISchema(context.Background(), apisecurity.ValidateAPISchemaRequest{
+		Schema: apisecurity.APISchema{
+			Type:     "openapi1",
+			Language: apisecurity.LanguageJSON,
+			Schema:   `{"openapi":"3.0.0"}`,
+		},
+	})
+	if err == nil || err.Error() != "Invalid schema: Provided schema type is not supported." {
+		t.Fatalf("ValidateAPISchema() error got = %v, want invalid schema error", err)
+	}
+}
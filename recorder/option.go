@@ -0,0 +1,44 @@
+// Package recorder provides an http.RoundTripper which records postman api traffic to a cassette file
+// and replays it, for deterministic integration tests.
+package recorder
+
+import "net/http"
+
+type options struct {
+	transport   http.RoundTripper
+	redactPaths []string
+}
+
+// Option represents functional options for configuring the recorder.
+type Option interface {
+	apply(*options)
+}
+
+type transportOption struct {
+	t http.RoundTripper
+}
+
+func (t transportOption) apply(opts *options) {
+	if t.t != nil {
+		opts.transport = t.t
+	}
+}
+
+// WithTransport configures the recorder to send requests in record mode using the given transport
+// instead of http.DefaultTransport.
+func WithTransport(t http.RoundTripper) Option {
+	return transportOption{t: t}
+}
+
+type redactedPathsOption []string
+
+func (r redactedPathsOption) apply(opts *options) {
+	opts.redactPaths = append(opts.redactPaths, r...)
+}
+
+// WithRedactedPaths configures additional json paths of request and response bodies to scrub
+// from the cassette, e.g. "environment.values.value". Paths are dot separated object keys where
+// "*" matches any key.
+func WithRedactedPaths(paths ...string) Option {
+	return redactedPathsOption(paths)
+}
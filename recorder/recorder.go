@@ -0,0 +1,282 @@
+// Package recorder provides an http.RoundTripper which records postman api traffic to a cassette file
+// and replays it, for deterministic integration tests.
+//
+// Record the interactions once against the real api:
+//
+//	rec, err := recorder.New("testdata/collections.json", recorder.ModeRecord)
+//	cs := postman.NewClientSet(apiKey, postman.WithHTTPClient(rec.Client()))
+//	// ... make requests ...
+//	err = rec.Stop()
+//
+// Then replay them in CI by creating the recorder with ModeReplay.
+// API keys and secrets are scrubbed from the cassette before it is written.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/actatum/postman-client/internal/redact"
+)
+
+// ErrNoInteraction is returned in replay mode for requests without a matching recorded interaction.
+var ErrNoInteraction = errors.New("recorder: no recorded interaction matches request")
+
+// cassetteVersion is the version of the cassette file format.
+const cassetteVersion = 1
+
+// Mode is the mode of operation of a Recorder.
+type Mode int
+
+// Possible values for recorder modes.
+const (
+	// ModeRecord sends requests to the api and records the interactions.
+	ModeRecord Mode = iota
+	// ModeReplay replays recorded interactions without sending any request.
+	ModeReplay
+)
+
+// Cassette holds recorded interactions.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request/response pair.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording or replaying interactions with the postman api.
+type Recorder struct {
+	path        string
+	mode        Mode
+	transport   http.RoundTripper
+	redactPaths []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a Recorder for the cassette at path. In replay mode the cassette must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	options := options{
+		transport: http.DefaultTransport,
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	r := &Recorder{
+		path:        path,
+		mode:        mode,
+		transport:   options.transport,
+		redactPaths: append(append([]string{}, redact.DefaultPaths...), options.redactPaths...),
+		cassette:    Cassette{Version: cassetteVersion},
+	}
+
+	if mode == ModeReplay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("recorder: reading cassette: %w", err)
+		}
+		if err = json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("recorder: decoding cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Client returns an http.Client using the recorder as its transport, e.g. for postman.WithHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Mode returns the mode of operation of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Stop writes the recorded interactions to the cassette file. It is a no-op in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(b, '\n'), 0o600)
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: redact.Headers(req.Header),
+		Body:   string(redact.JSON(body, r.redactPaths...)),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     redact.Headers(resp.Header),
+			Body:       string(redact.JSON(body, r.redactPaths...)),
+		},
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+
+		recordedResp := interaction.Response
+		header := recordedResp.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recordedResp.StatusCode, http.StatusText(recordedResp.StatusCode)),
+			StatusCode:    recordedResp.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(recordedResp.Body)),
+			ContentLength: int64(len(recordedResp.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s in %s", ErrNoInteraction, req.Method, req.URL.String(), r.path)
+}
+
+// matches reports whether the recorded request has the same method, path, query and body as the given one.
+func matches(recorded, req Request) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	reqURL, err := url.Parse(req.URL)
+	if err != nil {
+		return false
+	}
+	if recordedURL.Path != reqURL.Path || recordedURL.Query().Encode() != reqURL.Query().Encode() {
+		return false
+	}
+
+	return equalBodies(recorded.Body, req.Body)
+}
+
+// equalBodies compares json bodies semantically and other bodies byte for byte.
+func equalBodies(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var av, bv interface{}
+	if json.Unmarshal([]byte(a), &av) != nil || json.Unmarshal([]byte(b), &bv) != nil {
+		return false
+	}
+	ab, err := json.Marshal(av)
+	if err != nil {
+		return false
+	}
+	bb, err := json.Marshal(bv)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ab, bb)
+}
+
+// readRequestBody returns the request body, leaving it readable for the transport.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
@@ -0,0 +1,146 @@
+// Package recorder provides an http.RoundTripper which records postman api traffic to a cassette file
+// and replays it, for deterministic integration tests.
+package recorder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	postman "github.com/actatum/postman-client"
+	"github.com/actatum/postman-client/environments"
+	"github.com/actatum/postman-client/postmantest"
+	"github.com/actatum/postman-client/rest"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	srv := postmantest.NewServer()
+
+	rec, err := New(cassette, ModeRecord, WithTransport(srv.Client().Transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := srv.ClientSet(postman.WithHTTPClient(rec.Client()))
+
+	env, err := cs.Environments().Create(ctx, environments.Environment{
+		Name: "Recorded",
+		Values: []environments.EnvironmentValue{
+			{Key: "token", Value: "s3cr3t-token", Enabled: true, Type: environments.EnvironmentValueTypeSecret},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.FailNext(http.StatusNotFound, "instanceNotFoundError", "We could not find the collection you are looking for")
+	if _, err = cs.Collections().Get(ctx, "abc"); !errors.Is(err, rest.ErrNotFound) {
+		t.Fatalf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+	if err = rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	b, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{postmantest.APIKey, "s3cr3t-token"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("cassette contains %q, want it scrubbed", secret)
+		}
+	}
+
+	replay, err := New(cassette, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs = srv.ClientSet(postman.WithHTTPClient(replay.Client()))
+
+	got, err := cs.Environments().Create(ctx, environments.Environment{
+		Name: "Recorded",
+		Values: []environments.EnvironmentValue{
+			{Key: "token", Value: "another-secret", Enabled: true, Type: environments.EnvironmentValueTypeSecret},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create() error got = %v, want nil", err)
+	}
+	if got.ID != env.ID {
+		t.Errorf("Create() got = %v, want %v", got.ID, env.ID)
+	}
+
+	if _, err = cs.Collections().Get(ctx, "abc"); !errors.Is(err, rest.ErrNotFound) {
+		t.Errorf("Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+
+	_, err = cs.Environments().Create(ctx, environments.Environment{Name: "Recorded"})
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("Create() error got = %v, want %v", err, ErrNoInteraction)
+	}
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("New() error got = %v, want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		recorded Request
+		req      Request
+		want     bool
+	}{
+		{
+			name:     "same request on another host",
+			recorded: Request{Method: "GET", URL: "https://api.getpostman.com/collections?workspace=1"},
+			req:      Request{Method: "GET", URL: "http://127.0.0.1:1234/collections?workspace=1"},
+			want:     true,
+		},
+		{
+			name:     "different method",
+			recorded: Request{Method: "GET", URL: "https://api.getpostman.com/collections"},
+			req:      Request{Method: "DELETE", URL: "https://api.getpostman.com/collections"},
+			want:     false,
+		},
+		{
+			name:     "different query",
+			recorded: Request{Method: "GET", URL: "https://api.getpostman.com/collections?workspace=1"},
+			req:      Request{Method: "GET", URL: "https://api.getpostman.com/collections?workspace=2"},
+			want:     false,
+		},
+		{
+			name:     "query order ignored",
+			recorded: Request{Method: "GET", URL: "https://api.getpostman.com/audit/logs?limit=1&since=a"},
+			req:      Request{Method: "GET", URL: "https://api.getpostman.com/audit/logs?since=a&limit=1"},
+			want:     true,
+		},
+		{
+			name:     "json key order ignored",
+			recorded: Request{Method: "POST", URL: "https://api.getpostman.com/mocks", Body: `{"a":1,"b":2}`},
+			req:      Request{Method: "POST", URL: "https://api.getpostman.com/mocks", Body: `{"b":2, "a":1}`},
+			want:     true,
+		},
+		{
+			name:     "different body",
+			recorded: Request{Method: "POST", URL: "https://api.getpostman.com/mocks", Body: `{"a":1}`},
+			req:      Request{Method: "POST", URL: "https://api.getpostman.com/mocks", Body: `{"a":2}`},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matches(tt.recorded, tt.req); got != tt.want {
+				t.Errorf("matches() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
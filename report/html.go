@@ -0,0 +1,74 @@
+// Package report renders the results of local collection runs and monitor runs as JUnit XML,
+// a JSON summary or a self-contained HTML page, e.g. to publish them from a CI pipeline.
+package report
+
+import (
+	"html/template"
+	"io"
+	"time"
+)
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"name":     itemName,
+	"duration": formatDuration,
+	"time": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.UTC().Format(time.RFC1123)
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}} - {{.Status}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #222; }
+h1 { margin-bottom: 0.25rem; }
+table { border-collapse: collapse; margin: 1rem 0; }
+th, td { border: 1px solid #ddd; padding: 0.4rem 0.75rem; text-align: left; vertical-align: top; }
+th { background: #f5f5f5; }
+.passed { color: #1a7f37; }
+.failed { color: #cf222e; }
+.item { border: 1px solid #ddd; border-left: 4px solid #1a7f37; margin: 0.75rem 0; padding: 0.5rem 1rem; }
+.item.failed { border-left-color: #cf222e; color: inherit; }
+.item h2 { font-size: 1rem; margin: 0.25rem 0; }
+.meta { color: #666; font-family: monospace; }
+ul { margin: 0.25rem 0; padding-left: 1.25rem; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<p class="{{.Status}}">{{.Status}}</p>
+<table>
+<tr><th>Started</th><td>{{time .Started}}</td></tr>
+<tr><th>Completed</th><td>{{time .Completed}}</td></tr>
+<tr><th>Duration</th><td>{{duration .Duration}}</td></tr>
+<tr><th>Iterations</th><td>{{.Iterations}}</td></tr>
+</table>
+<table>
+<tr><th></th><th>Total</th><th>Failed</th></tr>
+<tr><th>Requests</th><td>{{.Summary.Requests.Total}}</td><td>{{.Summary.Requests.Failed}}</td></tr>
+<tr><th>Assertions</th><td>{{.Summary.Assertions.Total}}</td><td>{{.Summary.Assertions.Failed}}</td></tr>
+</table>
+{{$report := .}}{{range .Items}}
+<div class="item{{if not .Passed}} failed{{end}}">
+<h2>{{name $report .}}</h2>
+<p class="meta">{{.Method}} {{.URL}}{{if .StatusCode}} &rarr; {{.StatusCode}}{{end}} ({{duration .Duration}})</p>
+{{if .Error}}<p class="failed">{{.Error}}</p>{{end}}
+{{if .Assertions}}<ul>{{range .Assertions}}
+<li class="{{if .Passed}}passed{{else}}failed{{end}}">{{.Name}}{{if .Message}}: {{.Message}}{{end}}</li>{{end}}
+</ul>{{end}}
+</div>{{end}}
+</body>
+</html>
+`))
+
+// WriteHTML writes the report as a self-contained HTML page.
+func WriteHTML(w io.Writer, r Report) error {
+	return htmlTemplate.Execute(w, r)
+}
+
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
@@ -0,0 +1,107 @@
+// Package report renders the results of local collection runs and monitor runs as JUnit XML,
+// a JSON summary or a self-contained HTML page, e.g. to publish them from a CI pipeline.
+package report
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	ID        string          `xml:"id,attr,omitempty"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as JUnit XML, as understood by Jenkins or GitLab. Each item is
+// a test suite with a test case per assertion; items without assertions have a single test case
+// for the request, which errors when the request couldn't be sent.
+func WriteJUnit(w io.Writer, r Report) error {
+	suites := junitTestSuites{
+		Name: r.Name,
+		Time: seconds(r.Duration),
+	}
+
+	for _, item := range r.Items {
+		name := itemName(r, item)
+		suite := junitTestSuite{
+			Name: name,
+			ID:   item.ID,
+			Time: seconds(item.Duration),
+		}
+		if !r.Started.IsZero() {
+			suite.Timestamp = r.Started.UTC().Format(time.RFC3339)
+		}
+		className := strings.Join(append([]string{r.Name}, item.Path...), ".")
+
+		if item.Error != "" || len(item.Assertions) == 0 {
+			tc := junitTestCase{Name: item.Name(), ClassName: className, Time: seconds(item.Duration)}
+			if item.Error != "" {
+				tc.Error = &junitProblem{Message: item.Error, Type: "RequestError", Text: item.Error}
+				suite.Errors++
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+		for _, a := range item.Assertions {
+			tc := junitTestCase{Name: a.Name, ClassName: className, Time: seconds(0)}
+			if !a.Passed {
+				tc.Failure = &junitProblem{Message: a.Message, Type: "AssertionFailure", Text: a.Message}
+				suite.Failures++
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+
+		suite.Tests = len(suite.Cases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
@@ -0,0 +1,228 @@
+// Package report renders the results of local collection runs and monitor runs as JUnit XML,
+// a JSON summary or a self-contained HTML page, e.g. to publish them from a CI pipeline.
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/monitors"
+	"github.com/actatum/postman-client/runner"
+)
+
+// Possible values for the status of a report.
+const (
+	StatusPassed = "passed"
+	StatusFailed = "failed"
+)
+
+// Report is the summary of a run, with the result of each executed request.
+type Report struct {
+	Name       string        `json:"name"`
+	Status     string        `json:"status"`
+	Started    time.Time     `json:"started"`
+	Completed  time.Time     `json:"completed"`
+	Duration   time.Duration `json:"duration"`
+	Iterations int           `json:"iterations"`
+	Summary    Summary       `json:"summary"`
+	Items      []Item        `json:"items"`
+}
+
+// Summary holds the counts of requests and assertions of a run.
+type Summary struct {
+	Requests   Count `json:"requests"`
+	Assertions Count `json:"assertions"`
+}
+
+// Count is a total and failed count.
+type Count struct {
+	Total  int `json:"total"`
+	Failed int `json:"failed"`
+}
+
+// Item is the result of an executed request. Error is set when the request couldn't be sent.
+type Item struct {
+	ID string `json:"id,omitempty"`
+	// Path holds the names of the parent folders followed by the name of the item.
+	Path       []string      `json:"path"`
+	Iteration  int           `json:"iteration"`
+	Method     string        `json:"method,omitempty"`
+	URL        string        `json:"url,omitempty"`
+	StatusCode int           `json:"code,omitempty"`
+	Duration   time.Duration `json:"duration"`
+	Error      string        `json:"error,omitempty"`
+	Assertions []Assertion   `json:"assertions,omitempty"`
+}
+
+// Name returns the name of the item.
+func (i Item) Name() string {
+	if len(i.Path) == 0 {
+		return ""
+	}
+	return i.Path[len(i.Path)-1]
+}
+
+// Passed reports whether the request was sent and every assertion passed.
+func (i Item) Passed() bool {
+	if i.Error != "" {
+		return false
+	}
+	for _, a := range i.Assertions {
+		if !a.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Assertion is the result of an assertion of an item.
+type Assertion struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+// FromResult builds the report of a local collection run.
+func FromResult(result *runner.Result) Report {
+	r := Report{
+		Name:       result.Collection,
+		Status:     StatusPassed,
+		Started:    result.Started,
+		Completed:  result.Completed,
+		Duration:   result.Completed.Sub(result.Started),
+		Iterations: len(result.Iterations),
+	}
+
+	for _, it := range result.Iterations {
+		for _, e := range it.Executions {
+			item := Item{
+				ID:        e.ItemID,
+				Path:      e.Path,
+				Iteration: it.Index,
+				Method:    e.Request.Method,
+				URL:       e.Request.URL,
+				Duration:  e.Timings.Total,
+			}
+			if e.Response != nil {
+				item.StatusCode = e.Response.StatusCode
+			}
+			if e.Err != nil {
+				item.Error = e.Err.Error()
+				r.Summary.Requests.Failed++
+			}
+			for _, a := range e.Assertions {
+				item.Assertions = append(item.Assertions, Assertion{
+					Name:    a.Assertion.Name,
+					Passed:  a.Passed(),
+					Message: a.Error,
+				})
+				r.Summary.Assertions.Total++
+				if !a.Passed() {
+					r.Summary.Assertions.Failed++
+				}
+			}
+			r.Summary.Requests.Total++
+			r.Items = append(r.Items, item)
+		}
+	}
+
+	if !result.Passed() {
+		r.Status = StatusFailed
+	}
+	return r
+}
+
+// FromMonitorRun builds the report of a monitor run, e.g. returned by monitors.Client.RunMonitor.
+// The counts are taken from the stats of the run. Monitor runs only list the failed assertions of
+// an execution, so the items only hold those.
+func FromMonitorRun(run monitors.Run) Report {
+	started, completed := run.Info.StartedAt, run.Info.FinishedAt
+	if started.IsZero() {
+		started, completed = run.StartedAt, run.FinishedAt
+	}
+	r := Report{
+		Name:       run.Info.Name,
+		Status:     StatusPassed,
+		Started:    started,
+		Completed:  completed,
+		Duration:   completed.Sub(started),
+		Iterations: 1,
+		Summary: Summary{
+			Requests:   Count{Total: run.Stats.Requests.Total, Failed: run.Stats.Requests.Failed},
+			Assertions: Count{Total: run.Stats.Assertions.Total, Failed: run.Stats.Assertions.Failed},
+		},
+	}
+
+	indexes := map[int]int{}
+	for _, e := range run.Executions {
+		indexes[e.ID] = len(r.Items)
+		r.Items = append(r.Items, Item{
+			ID:         e.Item.ID,
+			Path:       []string{e.Item.Name},
+			Method:     e.Request.Method,
+			URL:        e.Request.URL,
+			StatusCode: e.Response.Code,
+			Duration:   time.Duration(e.Response.ResponseTime) * time.Millisecond,
+		})
+	}
+
+	for _, f := range run.Failures {
+		i, ok := indexes[f.ExecutionID]
+		if !ok {
+			// The failure isn't related to an execution, e.g. the run timed out.
+			r.Items = append(r.Items, Item{Path: []string{f.Name}, Error: f.Message})
+			continue
+		}
+		if len(f.Assertion) == 0 {
+			r.Items[i].Error = f.Message
+			continue
+		}
+		names := make([]string, 0, len(f.Assertion))
+		for name := range f.Assertion {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			a := Assertion{Name: name, Passed: f.Assertion[name]}
+			if !a.Passed {
+				a.Message = f.Message
+			}
+			r.Items[i].Assertions = append(r.Items[i].Assertions, a)
+		}
+	}
+
+	status := run.Info.Status
+	if status == "" {
+		status = run.Status
+	}
+	if len(run.Failures) > 0 || r.Summary.Requests.Failed > 0 || r.Summary.Assertions.Failed > 0 ||
+		(status != "" && status != "success") {
+		r.Status = StatusFailed
+	}
+	return r
+}
+
+// Passed reports whether the run passed.
+func (r Report) Passed() bool {
+	return r.Status == StatusPassed
+}
+
+// WriteJSON writes the report as indented json.
+func WriteJSON(w io.Writer, r Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// itemName returns the path of an item, with its iteration when the run had several.
+func itemName(r Report, i Item) string {
+	name := strings.Join(i.Path, " / ")
+	if r.Iterations > 1 {
+		name += " [iteration " + strconv.Itoa(i.Iteration+1) + "]"
+	}
+	return name
+}
@@ -0,0 +1,202 @@
+// Package report renders the results of local collection runs and monitor runs as JUnit XML,
+// a JSON summary or a self-contained HTML page, e.g. to publish them from a CI pipeline.
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/actatum/postman-client/monitors"
+	"github.com/actatum/postman-client/runner"
+)
+
+var started = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func testResult() *runner.Result {
+	return &runner.Result{
+		Collection: "Orders",
+		Started:    started,
+		Completed:  started.Add(2 * time.Second),
+		Iterations: []runner.Iteration{{
+			Executions: []runner.Execution{
+				{
+					ItemID:   "get-order",
+					Path:     []string{"Orders", "Get order"},
+					Request:  runner.RequestRecord{Method: http.MethodGet, URL: "http://localhost/orders/1"},
+					Response: &runner.Response{StatusCode: http.StatusOK},
+					Timings:  runner.Timings{Total: 120 * time.Millisecond},
+					Assertions: []runner.AssertionResult{
+						{Assertion: runner.StatusIs(200)},
+						{Assertion: runner.JSONPathExists("$.id"), Error: "$.id doesn't exist"},
+					},
+				},
+				{
+					Path:    []string{"Orders", "Delete <order>"},
+					Request: runner.RequestRecord{Method: http.MethodDelete, URL: "http://localhost/orders/1"},
+					Err:     errors.New("connection refused"),
+				},
+			},
+		}},
+	}
+}
+
+func TestFromResult(t *testing.T) {
+	got := FromResult(testResult())
+
+	want := Report{
+		Name:       "Orders",
+		Status:     StatusFailed,
+		Started:    started,
+		Completed:  started.Add(2 * time.Second),
+		Duration:   2 * time.Second,
+		Iterations: 1,
+		Summary: Summary{
+			Requests:   Count{Total: 2, Failed: 1},
+			Assertions: Count{Total: 2, Failed: 1},
+		},
+		Items: []Item{
+			{
+				ID:         "get-order",
+				Path:       []string{"Orders", "Get order"},
+				Method:     http.MethodGet,
+				URL:        "http://localhost/orders/1",
+				StatusCode: http.StatusOK,
+				Duration:   120 * time.Millisecond,
+				Assertions: []Assertion{
+					{Name: "status is 200", Passed: true},
+					{Name: "$.id exists", Message: "$.id doesn't exist"},
+				},
+			},
+			{
+				Path:   []string{"Orders", "Delete <order>"},
+				Method: http.MethodDelete,
+				URL:    "http://localhost/orders/1",
+				Error:  "connection refused",
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromResult() got = %+v, want %+v", got, want)
+	}
+}
+
+func TestFromMonitorRun(t *testing.T) {
+	run := monitors.Run{
+		Info: monitors.RunInfo{
+			Name:       "Nightly",
+			Status:     "failed",
+			StartedAt:  started,
+			FinishedAt: started.Add(time.Second),
+		},
+		Stats: monitors.Stats{
+			Assertions: monitors.Assertions{Total: 4, Failed: 1},
+			Requests:   monitors.Requests{Total: 2},
+		},
+		Executions: []monitors.RunExecution{
+			{
+				ID:       1,
+				Item:     monitors.RunItem{ID: "get-order", Name: "Get order"},
+				Request:  monitors.RunRequest{Method: http.MethodGet, URL: "https://api.example.com/orders/1"},
+				Response: monitors.RunResponse{Code: http.StatusInternalServerError, ResponseTime: 80},
+			},
+			{ID: 2, Item: monitors.RunItem{Name: "List orders"}, Response: monitors.RunResponse{Code: http.StatusOK}},
+		},
+		Failures: []monitors.RunFailure{{
+			ExecutionID: 1,
+			Name:        "AssertionError",
+			Message:     "expected response to have status code 200 but got 500",
+			Assertion:   map[string]bool{"Status code is 200": false},
+		}},
+	}
+
+	got := FromMonitorRun(run)
+	if got.Name != "Nightly" || got.Passed() || got.Duration != time.Second {
+		t.Errorf("FromMonitorRun() got = %+v, want a failed report of Nightly", got)
+	}
+	if want := (Summary{Requests: Count{Total: 2}, Assertions: Count{Total: 4, Failed: 1}}); got.Summary != want {
+		t.Errorf("FromMonitorRun() summary got = %+v, want %+v", got.Summary, want)
+	}
+	if len(got.Items) != 2 {
+		t.Fatalf("FromMonitorRun() got %d items, want 2", len(got.Items))
+	}
+	want := Item{
+		ID:         "get-order",
+		Path:       []string{"Get order"},
+		Method:     http.MethodGet,
+		URL:        "https://api.example.com/orders/1",
+		StatusCode: http.StatusInternalServerError,
+		Duration:   80 * time.Millisecond,
+		Assertions: []Assertion{{Name: "Status code is 200", Message: run.Failures[0].Message}},
+	}
+	if !reflect.DeepEqual(got.Items[0], want) {
+		t.Errorf("FromMonitorRun() item got = %+v, want %+v", got.Items[0], want)
+	}
+	if !got.Items[1].Passed() {
+		t.Errorf("FromMonitorRun() item got = %+v, want it to pass", got.Items[1])
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, FromResult(testResult())); err != nil {
+		t.Fatal(err)
+	}
+
+	var got junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("WriteJUnit() wrote invalid xml: %v\n%s", err, buf.String())
+	}
+	if got.Tests != 3 || got.Failures != 1 || got.Errors != 1 || len(got.Suites) != 2 {
+		t.Errorf("WriteJUnit() got = %+v, want 3 tests in 2 suites, 1 failure and 1 error", got)
+	}
+	if suite := got.Suites[0]; suite.Name != "Orders / Get order" || suite.Time != "0.120" ||
+		suite.Cases[1].Failure == nil || suite.Cases[1].ClassName != "Orders.Orders.Get order" {
+		t.Errorf("WriteJUnit() suite got = %+v, want the assertions of Get order", suite)
+	}
+	if c := got.Suites[1].Cases[0]; c.Error == nil || c.Error.Message != "connection refused" {
+		t.Errorf("WriteJUnit() case got = %+v, want a request error", c)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	r := FromResult(testResult())
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+
+	var got Report
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, r) {
+		t.Errorf("WriteJSON() got = %+v, want %+v", got, r)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteHTML(&buf, FromResult(testResult())); err != nil {
+		t.Fatal(err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"<title>Orders - failed</title>",
+		"Orders / Get order",
+		"$.id exists: $.id doesn&#39;t exist",
+		"Orders / Delete &lt;order&gt;",
+		"connection refused",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteHTML() got = %s, want it to contain %q", got, want)
+		}
+	}
+}
@@ -5,29 +5,42 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
-	"net/http/httputil"
+	"time"
 )
 
 const (
 	baseURL = "https://api.getpostman.com"
+
+	// Version is the version of the postman client, sent in the default User-Agent header.
+	Version = "0.1.0"
+
+	defaultUserAgent = "postman-client-go/" + Version
 )
 
 // Client handles interacting with the postman api.
 type Client struct {
-	httpClient *http.Client
-	apiKey     string
-	baseURL    string
-	logOutput  io.Writer
+	httpClient  *http.Client
+	apiKey      string
+	baseURL     string
+	userAgent   string
+	headers     http.Header
+	logger      *slog.Logger
+	redactPaths []string
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
+	middleware  []Middleware
+	callMW      []Middleware
 }
 
 // NewClient returns a new instance of the Client.
 func NewClient(apiKey string, opts ...Option) *Client {
 	options := options{
 		httpClient: &http.Client{},
-		debugLog:   nil,
+		baseURL:    baseURL,
+		logger:     nil,
 	}
 
 	for _, o := range opts {
@@ -35,18 +48,38 @@ func NewClient(apiKey string, opts ...Option) *Client {
 	}
 
 	return &Client{
-		httpClient: options.httpClient,
-		apiKey:     apiKey,
-		baseURL:    baseURL,
-		logOutput:  options.debugLog,
+		httpClient:  options.httpClient,
+		apiKey:      apiKey,
+		baseURL:     options.baseURL,
+		userAgent:   userAgent(options.userAgent),
+		headers:     options.headers,
+		logger:      options.logger,
+		redactPaths: options.redactPaths,
+		retryPolicy: options.retryPolicy,
+		limiter:     newRateLimiter(options.rateLimit.n, options.rateLimit.per),
+		middleware:  options.middleware,
+		callMW:      options.callMW,
 	}
 }
 
+// userAgent prefixes the default user agent with the given application user agent.
+func userAgent(ua string) string {
+	if ua == "" {
+		return defaultUserAgent
+	}
+	return ua + " " + defaultUserAgent
+}
+
 // BaseURL returns the baseURL for the rest client.
 func (c *Client) BaseURL() string {
 	return c.baseURL
 }
 
+// HTTPClient returns the http client sending the requests of the rest client.
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
 // NewRequest creates a new http request.
 func (c *Client) NewRequest(ctx context.Context, method, url string, payload interface{}) (*http.Request, error) {
 	var buf io.Reader
@@ -61,6 +94,7 @@ func (c *Client) NewRequest(ctx context.Context, method, url string, payload int
 }
 
 // DoRequest makes the http request and unmarshalls the response into the result interface.
+// Transient failures are retried according to the client's RetryPolicy.
 func (c *Client) DoRequest(r *http.Request, result interface{}, opts ...RequestOption) error {
 	options := requestOptions{
 		contentType: "application/json",
@@ -78,24 +112,27 @@ func (c *Client) DoRequest(r *http.Request, result interface{}, opts ...RequestO
 	r.URL.RawQuery = query.Encode()
 
 	// Set default headers
+	for k, v := range c.headers {
+		r.Header[k] = append([]string(nil), v...)
+	}
+	r.Header.Set("User-Agent", c.userAgent)
 	r.Header.Set("X-Api-Key", c.apiKey)
 	r.Header.Set("Accept", "application/json")
 	r.Header.Set("Content-Type", options.contentType)
 
-	resp, err := c.httpClient.Do(r)
+	start := time.Now()
+	resp, err := chain(DoerFunc(c.send), c.callMW).Do(r)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
-	c.log(r, resp)
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		var errResp ErrorResponse
-		if err = json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return err
-		}
+	if options.response != nil {
+		*options.response = newResponse(resp, time.Since(start), time.Now())
+	}
 
-		return errResp.Error
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newError(r, resp)
 	}
 
 	if result == nil {
@@ -112,22 +149,3 @@ func (c *Client) DoRequest(r *http.Request, result interface{}, opts ...RequestO
 
 	return json.NewDecoder(resp.Body).Decode(result)
 }
-
-func (c *Client) log(r *http.Request, resp *http.Response) {
-	if c.logOutput == nil {
-		return
-	}
-
-	var (
-		reqDump  string
-		respDump []byte
-	)
-	if r != nil {
-		reqDump = fmt.Sprintf("%s %s Data: %s", r.Method, r.URL.String(), r.Form.Encode())
-	}
-	if resp != nil {
-		respDump, _ = httputil.DumpResponse(resp, true)
-	}
-
-	_, _ = fmt.Fprintf(c.logOutput, "Request: %s\nResponse: %s\n", reqDump, string(respDump))
-}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -38,6 +39,7 @@ func TestNewClient(t *testing.T) {
 				httpClient: &http.Client{},
 				apiKey:     "hi",
 				baseURL:    baseURL,
+				userAgent:  defaultUserAgent,
 			},
 		},
 		{
@@ -62,7 +64,8 @@ func TestNewClient(t *testing.T) {
 				},
 				apiKey:    "",
 				baseURL:   baseURL,
-				logOutput: nil,
+				userAgent: defaultUserAgent,
+				logger:    nil,
 			},
 		},
 		{
@@ -77,7 +80,56 @@ func TestNewClient(t *testing.T) {
 				httpClient: &http.Client{},
 				apiKey:     "",
 				baseURL:    baseURL,
-				logOutput:  os.Stdout,
+				userAgent:  defaultUserAgent,
+				logger:     newWriterLogger(os.Stdout),
+			},
+		},
+		{
+			name: "base url option",
+			args: args{
+				apiKey: "",
+				opts: []Option{
+					WithBaseURL("http://localhost:8080/postman/"),
+				},
+			},
+			want: &Client{
+				httpClient: &http.Client{},
+				apiKey:     "",
+				baseURL:    "http://localhost:8080/postman",
+				userAgent:  defaultUserAgent,
+			},
+		},
+		{
+			name: "user agent and default headers options",
+			args: args{
+				apiKey: "",
+				opts: []Option{
+					WithUserAgent("nightly-sync/1.2"),
+					WithDefaultHeaders(http.Header{"x-team": []string{"platform"}}),
+				},
+			},
+			want: &Client{
+				httpClient: &http.Client{},
+				apiKey:     "",
+				baseURL:    baseURL,
+				userAgent:  "nightly-sync/1.2 " + defaultUserAgent,
+				headers:    http.Header{"X-Team": []string{"platform"}},
+			},
+		},
+		{
+			name: "retry policy option",
+			args: args{
+				apiKey: "",
+				opts: []Option{
+					WithRetryPolicy(DefaultRetryPolicy()),
+				},
+			},
+			want: &Client{
+				httpClient:  &http.Client{},
+				apiKey:      "",
+				baseURL:     baseURL,
+				userAgent:   defaultUserAgent,
+				retryPolicy: DefaultRetryPolicy(),
 			},
 		},
 		{
@@ -92,7 +144,8 @@ func TestNewClient(t *testing.T) {
 				httpClient: &http.Client{},
 				apiKey:     "",
 				baseURL:    baseURL,
-				logOutput:  os.Stdout,
+				userAgent:  defaultUserAgent,
+				logger:     newWriterLogger(os.Stdout),
 			},
 		},
 	}
@@ -237,7 +290,7 @@ func TestClient_DoRequest(t *testing.T) {
 	type fields struct {
 		httpClient *http.Client
 		apiKey     string
-		logOutput  io.Writer
+		logger     *slog.Logger
 	}
 	type args struct {
 		method string
@@ -257,7 +310,7 @@ func TestClient_DoRequest(t *testing.T) {
 			fields: fields{
 				httpClient: &http.Client{},
 				apiKey:     "api-key",
-				logOutput:  nil,
+				logger:     nil,
 			},
 			args: args{
 				method: http.MethodGet,
@@ -277,7 +330,7 @@ func TestClient_DoRequest(t *testing.T) {
 			fields: fields{
 				httpClient: &http.Client{},
 				apiKey:     "api-key",
-				logOutput:  nil,
+				logger:     nil,
 			},
 			args: args{
 				method: http.MethodGet,
@@ -299,7 +352,7 @@ func TestClient_DoRequest(t *testing.T) {
 			fields: fields{
 				httpClient: &http.Client{},
 				apiKey:     "api-key",
-				logOutput:  nil,
+				logger:     nil,
 			},
 			args: args{
 				method: http.MethodPost,
@@ -319,7 +372,7 @@ func TestClient_DoRequest(t *testing.T) {
 			fields: fields{
 				httpClient: &http.Client{},
 				apiKey:     "api-key",
-				logOutput:  nil,
+				logger:     nil,
 			},
 			args: args{
 				method: http.MethodPost,
@@ -341,7 +394,7 @@ func TestClient_DoRequest(t *testing.T) {
 			c := &Client{
 				httpClient: tt.fields.httpClient,
 				apiKey:     tt.fields.apiKey,
-				logOutput:  tt.fields.logOutput,
+				logger:     tt.fields.logger,
 			}
 			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				if r.Header.Get("x-api-key") != tt.fields.apiKey {
@@ -464,3 +517,43 @@ func TestClient_DoRequestIntegration(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_DoRequestHeaders(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]string{
+			"User-Agent": "nightly-sync/1.2 " + defaultUserAgent,
+			"X-Api-Key":  "api-key",
+			"X-Team":     "platform",
+			"Accept":     "application/json",
+		}
+		for k, v := range want {
+			if got := r.Header.Get(k); got != v {
+				t.Errorf("r.Header.Get(%s) got = %v, want %v", k, got, v)
+			}
+		}
+		if r.URL.Path != "/postman/collections" {
+			t.Errorf("r.URL.Path got = %v, want %v", r.URL.Path, "/postman/collections")
+		}
+		w.Write([]byte(`{}`))
+	})
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	c := NewClient(
+		"api-key",
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL+"/postman"),
+		WithUserAgent("nightly-sync/1.2"),
+		WithDefaultHeaders(http.Header{
+			"X-Team":    []string{"platform"},
+			"X-Api-Key": []string{"overridden"},
+		}),
+	)
+	r, err := c.NewRequest(context.Background(), http.MethodGet, c.BaseURL()+"/collections", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.DoRequest(r, nil); err != nil {
+		t.Fatal(err)
+	}
+}
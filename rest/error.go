@@ -2,11 +2,31 @@
 package rest
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 )
 
+// Sentinel errors for common failure classes. An *Error matches them with errors.Is
+// based on the HTTP status code of the response, e.g. errors.Is(err, rest.ErrNotFound).
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrValidation   = errors.New("validation failed")
+)
+
+// maxErrorBodySize caps how much of a non JSON error body is kept in Error.Message.
+const maxErrorBodySize = 512
+
+// scimErrorSchema is the schema of error responses from the postman scim api.
+const scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
 // ErrorResponse represents an error response from the postman api.
 type ErrorResponse struct {
 	Error *Error `json:"error"`
@@ -17,6 +37,12 @@ type Error struct {
 	Name    string            `json:"name"`
 	Message string            `json:"message"`
 	Details map[string]string `json:"details"`
+
+	// The fields below describe the failed request, they are populated by Client.DoRequest.
+	StatusCode int    `json:"-"`
+	Method     string `json:"-"`
+	URL        string `json:"-"`
+	RequestID  string `json:"-"`
 }
 
 // Error satisfies the error interface.
@@ -24,6 +50,92 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%s: %s", e.Name, e.Message)
 }
 
+// Is reports whether the error matches one of the sentinel errors.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	default:
+		return false
+	}
+}
+
+// scimErrorResponse represents an error response from the postman scim api (RFC 7644 section 3.12).
+type scimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Detail   string   `json:"detail"`
+	ScimType string   `json:"scimType"`
+}
+
+// newError builds an *Error from a non 2xx response. Bodies which are not a postman
+// error document (e.g. an html page from a gateway) are kept as the error message.
+// SCIM error documents are mapped to an Error named after their scimType, with the
+// scimType kept in Details.
+func newError(r *http.Request, resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var errResp ErrorResponse
+	if err = json.Unmarshal(body, &errResp); err != nil || errResp.Error == nil {
+		errResp.Error = newSCIMError(resp.StatusCode, body)
+	}
+	if errResp.Error == nil {
+		errResp.Error = &Error{
+			Name:    http.StatusText(resp.StatusCode),
+			Message: truncate(string(bytes.TrimSpace(body)), maxErrorBodySize),
+		}
+	}
+
+	e := errResp.Error
+	e.StatusCode = resp.StatusCode
+	e.Method = r.Method
+	e.URL = r.URL.String()
+	e.RequestID = resp.Header.Get("X-Request-Id")
+
+	return e
+}
+
+// newSCIMError returns the Error described by a scim error document, or nil if body is not one.
+func newSCIMError(statusCode int, body []byte) *Error {
+	var scimErr scimErrorResponse
+	if err := json.Unmarshal(body, &scimErr); err != nil {
+		return nil
+	}
+	for _, schema := range scimErr.Schemas {
+		if schema != scimErrorSchema {
+			continue
+		}
+
+		e := &Error{
+			Name:    http.StatusText(statusCode),
+			Message: scimErr.Detail,
+		}
+		if scimErr.ScimType != "" {
+			e.Name = scimErr.ScimType
+			e.Details = map[string]string{"scimType": scimErr.ScimType}
+		}
+		return e
+	}
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "") + "..."
+}
+
 // UnmarshalJSON customizes the json unmarshalling of Error.
 func (e *Error) UnmarshalJSON(data []byte) error {
 	var errorResult map[string]interface{}
@@ -2,7 +2,13 @@
 package rest
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -130,3 +136,180 @@ func TestError_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		target error
+		want   bool
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			target: ErrNotFound,
+			want:   true,
+		},
+		{
+			name:   "unauthorized",
+			status: http.StatusUnauthorized,
+			target: ErrUnauthorized,
+			want:   true,
+		},
+		{
+			name:   "forbidden",
+			status: http.StatusForbidden,
+			target: ErrForbidden,
+			want:   true,
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			target: ErrRateLimited,
+			want:   true,
+		},
+		{
+			name:   "validation bad request",
+			status: http.StatusBadRequest,
+			target: ErrValidation,
+			want:   true,
+		},
+		{
+			name:   "validation unprocessable entity",
+			status: http.StatusUnprocessableEntity,
+			target: ErrValidation,
+			want:   true,
+		},
+		{
+			name:   "mismatched status",
+			status: http.StatusBadRequest,
+			target: ErrNotFound,
+			want:   false,
+		},
+		{
+			name:   "unrelated error",
+			status: http.StatusNotFound,
+			target: errors.New("not found"),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error = &Error{Name: "error", StatusCode: tt.status}
+			wrapped := fmt.Errorf("wrapped: %w", err)
+			if got := errors.Is(wrapped, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   *Error
+	}{
+		{
+			name:   "postman error",
+			status: http.StatusNotFound,
+			body:   `{"error":{"name":"instanceNotFoundError","message":"We could not find the collection"}}`,
+			want: &Error{
+				Name:       "instanceNotFoundError",
+				Message:    "We could not find the collection",
+				StatusCode: http.StatusNotFound,
+				Method:     http.MethodGet,
+				URL:        "https://api.getpostman.com/collections/123",
+				RequestID:  "req-123",
+			},
+		},
+		{
+			name:   "html gateway error",
+			status: http.StatusBadGateway,
+			body:   "<html><body>502 Bad Gateway</body></html>\n",
+			want: &Error{
+				Name:       "Bad Gateway",
+				Message:    "<html><body>502 Bad Gateway</body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     http.MethodGet,
+				URL:        "https://api.getpostman.com/collections/123",
+				RequestID:  "req-123",
+			},
+		},
+		{
+			name:   "unexpected json error",
+			status: http.StatusInternalServerError,
+			body:   `{"message":"boom"}`,
+			want: &Error{
+				Name:       "Internal Server Error",
+				Message:    `{"message":"boom"}`,
+				StatusCode: http.StatusInternalServerError,
+				Method:     http.MethodGet,
+				URL:        "https://api.getpostman.com/collections/123",
+				RequestID:  "req-123",
+			},
+		},
+		{
+			name:   "scim error",
+			status: http.StatusConflict,
+			body: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],` +
+				`"scimType":"uniqueness","detail":"User already exists","status":"409"}`,
+			want: &Error{
+				Name:       "uniqueness",
+				Message:    "User already exists",
+				Details:    map[string]string{"scimType": "uniqueness"},
+				StatusCode: http.StatusConflict,
+				Method:     http.MethodGet,
+				URL:        "https://api.getpostman.com/collections/123",
+				RequestID:  "req-123",
+			},
+		},
+		{
+			name:   "scim error without type",
+			status: http.StatusNotFound,
+			body:   `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"detail":"User not found","status":"404"}`,
+			want: &Error{
+				Name:       "Not Found",
+				Message:    "User not found",
+				StatusCode: http.StatusNotFound,
+				Method:     http.MethodGet,
+				URL:        "https://api.getpostman.com/collections/123",
+				RequestID:  "req-123",
+			},
+		},
+		{
+			name:   "long body is truncated",
+			status: http.StatusServiceUnavailable,
+			body:   strings.Repeat("a", maxErrorBodySize+10),
+			want: &Error{
+				Name:       "Service Unavailable",
+				Message:    strings.Repeat("a", maxErrorBodySize) + "...",
+				StatusCode: http.StatusServiceUnavailable,
+				Method:     http.MethodGet,
+				URL:        "https://api.getpostman.com/collections/123",
+				RequestID:  "req-123",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "https://api.getpostman.com/collections/123", nil)
+			resp := &http.Response{
+				StatusCode: tt.status,
+				Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}
+
+			err := newError(r, resp)
+
+			var got *Error
+			if !errors.As(err, &got) {
+				t.Fatalf("errors.As() got = %T, want %T", err, got)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newError() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
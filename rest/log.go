@@ -0,0 +1,94 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/actatum/postman-client/internal/redact"
+)
+
+// log emits a structured record for a single attempt of a request. Request and response bodies
+// are only logged, with secrets redacted, when the logger is enabled at debug level.
+func (c *Client) log(r *http.Request, resp *http.Response, err error, attempt int, elapsed time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	ctx := r.Context()
+
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Duration("duration", elapsed),
+	}
+	if attempt > 1 {
+		attrs = append(attrs, slog.Int("attempt", attempt))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "postman api request failed", attrs...)
+		return
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+
+	level := slog.LevelInfo
+	if resp.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	c.logger.LogAttrs(ctx, level, "postman api request", attrs...)
+
+	if !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	paths := append(append([]string{}, redact.DefaultPaths...), c.redactPaths...)
+	c.logger.LogAttrs(
+		ctx,
+		slog.LevelDebug,
+		"postman api request payload",
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Any("request_headers", redact.Headers(r.Header)),
+		slog.String("request_body", string(redact.JSON(requestBody(r), paths...))),
+		slog.String("response_body", string(redact.JSON(responseBody(resp), paths...))),
+	)
+}
+
+// requestBody returns a copy of the request body, if it can be read without consuming it.
+func requestBody(r *http.Request) []byte {
+	if r.GetBody == nil {
+		return nil
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	b, _ := io.ReadAll(body)
+	return b
+}
+
+// responseBody reads the response body and replaces it so it can still be decoded.
+func responseBody(resp *http.Response) []byte {
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// newWriterLogger returns a debug level text logger writing to w.
+func newWriterLogger(w io.Writer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
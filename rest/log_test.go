@@ -0,0 +1,101 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_DoRequestLogging(t *testing.T) {
+	const responseBody = `{"environment":{"values":[{"key":"token","value":"super-secret","type":"secret"},` +
+		`{"key":"host","value":"example.com","type":"default"}]},"webhook":{"webhookUrl":"https://hook/123"}}`
+
+	tests := []struct {
+		name        string
+		level       slog.Level
+		redact      []string
+		wantRecords int
+		wantContain []string
+		wantAbsent  []string
+	}{
+		{
+			name:        "info level omits bodies",
+			level:       slog.LevelInfo,
+			wantRecords: 1,
+			wantContain: []string{`"status":200`, `"request_id":"req-123"`, `"path":"/environments/123"`},
+			wantAbsent:  []string{"super-secret", "example.com", "PMAK-secret"},
+		},
+		{
+			name:        "debug level redacts bodies",
+			level:       slog.LevelDebug,
+			redact:      []string{"environment.values.key"},
+			wantRecords: 2,
+			wantContain: []string{`example.com`, `[REDACTED]`, `\"name\":\"test\"`},
+			wantAbsent:  []string{"super-secret", "https://hook/123", "PMAK-secret", `\"key\":\"host\"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-123")
+				w.Write([]byte(responseBody))
+			})
+			srv := httptest.NewServer(h)
+			t.Cleanup(srv.Close)
+
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: tt.level}))
+			c := NewClient(
+				"PMAK-secret",
+				WithHTTPClient(srv.Client()),
+				WithLogger(logger),
+				WithRedactedPaths(tt.redact...),
+			)
+
+			r, err := c.NewRequest(
+				context.Background(),
+				http.MethodPut,
+				srv.URL+"/environments/123",
+				map[string]string{"name": "test"},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var result map[string]interface{}
+			if err = c.DoRequest(r, &result); err != nil {
+				t.Fatal(err)
+			}
+			if result["environment"] == nil {
+				t.Errorf("result got = %v, want decoded environment", result)
+			}
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			if len(lines) != tt.wantRecords {
+				t.Fatalf("len(records) got = %v, want %v: %s", len(lines), tt.wantRecords, buf.String())
+			}
+			for _, line := range lines {
+				if !json.Valid([]byte(line)) {
+					t.Errorf("record is not valid json: %s", line)
+				}
+			}
+			out := buf.String()
+			for _, s := range tt.wantContain {
+				if !strings.Contains(out, s) {
+					t.Errorf("log output missing %q: %s", s, out)
+				}
+			}
+			for _, s := range tt.wantAbsent {
+				if strings.Contains(out, s) {
+					t.Errorf("log output contains %q: %s", s, out)
+				}
+			}
+		})
+	}
+}
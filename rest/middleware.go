@@ -0,0 +1,64 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Doer sends an http request and returns its response. *http.Client satisfies Doer.
+type Doer interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as a Doer.
+type DoerFunc func(r *http.Request) (*http.Response, error)
+
+// Do calls f(r).
+func (f DoerFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// Middleware wraps a Doer to add behavior around every request sent by the Client,
+// e.g. tracing, metrics, request signing or caching.
+//
+// Middleware sees the request after the client has set its headers. Middleware installed with
+// WithMiddleware runs once per attempt when a RetryPolicy is configured, while middleware installed
+// with WithCallMiddleware runs once per call.
+type Middleware func(next Doer) Doer
+
+// Attempt describes a single attempt made by the Client to send a request.
+type Attempt struct {
+	// Number is the 1 indexed number of the attempt.
+	Number int
+	// StatusCode is the status code of the response, zero if the attempt failed without one.
+	StatusCode int
+	// Err is the error returned by the http client, if any.
+	Err error
+	// Duration is the time spent on the attempt, excluding backoff and rate limit waits.
+	Duration time.Duration
+}
+
+type attemptObserverKey struct{}
+
+// ObserveAttempts returns a copy of ctx with which the Client calls fn after every attempt made
+// for requests using the context, e.g. to let call middleware record retries.
+func ObserveAttempts(ctx context.Context, fn func(Attempt)) context.Context {
+	return context.WithValue(ctx, attemptObserverKey{}, fn)
+}
+
+// observeAttempt reports the attempt to the observer of the request context, if any.
+func observeAttempt(ctx context.Context, a Attempt) {
+	if fn, ok := ctx.Value(attemptObserverKey{}).(func(Attempt)); ok {
+		fn(a)
+	}
+}
+
+// chain wraps d with the middleware, the first middleware being the outermost.
+func chain(d Doer, middleware []Middleware) Doer {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		d = middleware[i](d)
+	}
+	return d
+}
@@ -0,0 +1,160 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_DoRequestMiddleware(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request "+r.Header.Get("X-Api-Key"))
+				resp, err := next.Do(r)
+				if err == nil {
+					calls = append(calls, name+" response "+resp.Status)
+				}
+				return resp, err
+			})
+		}
+	}
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "signed" {
+			t.Errorf("r.Header.Get(X-Signature) got = %v, want %v", r.Header.Get("X-Signature"), "signed")
+		}
+		w.Write([]byte(`{"response":"here"}`))
+	})
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	sign := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			r.Header.Set("X-Signature", "signed")
+			return next.Do(r)
+		})
+	}
+
+	c := NewClient(
+		"api-key",
+		WithHTTPClient(srv.Client()),
+		WithMiddleware(record("first"), record("second")),
+		WithMiddleware(sign),
+	)
+	r, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result map[string]string
+	if err = c.DoRequest(r, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"first request api-key",
+		"second request api-key",
+		"second response 200 OK",
+		"first response 200 OK",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("middleware calls got = %v, want %v", calls, want)
+	}
+	if result["response"] != "here" {
+		t.Errorf("result got = %v, want %v", result, map[string]string{"response": "here"})
+	}
+}
+
+func TestClient_DoRequestMiddlewareShortCircuit(t *testing.T) {
+	cache := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`{"response":"cached"}`)),
+				Request:    r,
+			}, nil
+		})
+	}
+
+	c := NewClient("api-key", WithMiddleware(cache))
+	r, err := http.NewRequest(http.MethodGet, "http://unreachable.invalid", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result map[string]string
+	if err = c.DoRequest(r, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result["response"] != "cached" {
+		t.Errorf("result got = %v, want %v", result, map[string]string{"response": "cached"})
+	}
+}
+
+func TestClient_DoRequestCallMiddleware(t *testing.T) {
+	calls := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"response":"here"}`))
+	})
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	var perCall, perAttempt int
+	var attempts []Attempt
+	call := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			perCall++
+			ctx := ObserveAttempts(r.Context(), func(a Attempt) { attempts = append(attempts, a) })
+			return next.Do(r.WithContext(ctx))
+		})
+	}
+	attempt := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			perAttempt++
+			return next.Do(r)
+		})
+	}
+
+	c := NewClient(
+		"api-key",
+		WithHTTPClient(srv.Client()),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}),
+		WithCallMiddleware(call),
+		WithMiddleware(attempt),
+	)
+	r, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.DoRequest(r, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if perCall != 1 || perAttempt != 3 {
+		t.Errorf("middleware calls got = %v per call, %v per attempt, want 1, 3", perCall, perAttempt)
+	}
+	var codes []int
+	for i, a := range attempts {
+		if a.Number != i+1 {
+			t.Errorf("attempts[%d].Number got = %v, want %v", i, a.Number, i+1)
+		}
+		codes = append(codes, a.StatusCode)
+	}
+	if want := []int{503, 503, 200}; !reflect.DeepEqual(codes, want) {
+		t.Errorf("attempt status codes got = %v, want %v", codes, want)
+	}
+}
@@ -3,13 +3,24 @@ package rest
 
 import (
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 )
 
 type options struct {
-	httpClient *http.Client
-	debugLog   io.Writer
+	httpClient  *http.Client
+	baseURL     string
+	userAgent   string
+	headers     http.Header
+	logger      *slog.Logger
+	redactPaths []string
+	retryPolicy RetryPolicy
+	rateLimit   rateLimitOption
+	middleware  []Middleware
+	callMW      []Middleware
 }
 
 // Option represents functional options for configuring the client.
@@ -30,6 +41,51 @@ func WithHTTPClient(client *http.Client) Option {
 	return httpClientOption{c: client}
 }
 
+type baseURLOption string
+
+func (b baseURLOption) apply(opts *options) {
+	if b != "" {
+		opts.baseURL = strings.TrimRight(string(b), "/")
+	}
+}
+
+// WithBaseURL configures the client to send requests to the given base url instead of
+// https://api.getpostman.com, e.g. a regional endpoint, an egress proxy or a local stub server.
+func WithBaseURL(u string) Option {
+	return baseURLOption(u)
+}
+
+type userAgentOption string
+
+func (u userAgentOption) apply(opts *options) {
+	opts.userAgent = string(u)
+}
+
+// WithUserAgent prefixes the default postman-client-go/<version> User-Agent header with the given value.
+func WithUserAgent(ua string) Option {
+	return userAgentOption(ua)
+}
+
+type defaultHeadersOption http.Header
+
+func (d defaultHeadersOption) apply(opts *options) {
+	if len(d) == 0 {
+		return
+	}
+	if opts.headers == nil {
+		opts.headers = make(http.Header, len(d))
+	}
+	for k, v := range d {
+		opts.headers[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+	}
+}
+
+// WithDefaultHeaders configures headers sent with every request. The headers managed by the client
+// (X-Api-Key, Accept, Content-Type and User-Agent) cannot be overridden.
+func WithDefaultHeaders(h http.Header) Option {
+	return defaultHeadersOption(h)
+}
+
 type debugLogOption struct {
 	w io.Writer
 }
@@ -38,17 +94,102 @@ func (d debugLogOption) apply(opts *options) {
 	if d.w == nil {
 		d.w = os.Stdout
 	}
-	opts.debugLog = d.w
+	opts.logger = newWriterLogger(d.w)
 }
 
 // WithDebugLog configures the io.Writer to send debug logging output to.
+// Output is written as debug level slog text records, see WithLogger.
 func WithDebugLog(w io.Writer) Option {
 	return debugLogOption{w: w}
 }
 
+type loggerOption struct {
+	l *slog.Logger
+}
+
+func (l loggerOption) apply(opts *options) {
+	opts.logger = l.l
+}
+
+// WithLogger configures the client to log a structured record for every request with its method,
+// path, status, duration and request id. When the logger is enabled at debug level, request and
+// response bodies are logged as well, with api keys, secret environment values and the paths given
+// to WithRedactedPaths redacted.
+func WithLogger(l *slog.Logger) Option {
+	return loggerOption{l: l}
+}
+
+type redactedPathsOption []string
+
+func (r redactedPathsOption) apply(opts *options) {
+	opts.redactPaths = append(opts.redactPaths, r...)
+}
+
+// WithRedactedPaths configures additional json paths whose values are redacted from logged bodies.
+// Paths are dot separated object keys, where "*" matches any key and arrays are traversed implicitly,
+// e.g. "environment.values.value".
+func WithRedactedPaths(paths ...string) Option {
+	return redactedPathsOption(paths)
+}
+
+type retryPolicyOption struct {
+	p RetryPolicy
+}
+
+func (r retryPolicyOption) apply(opts *options) {
+	opts.retryPolicy = r.p
+}
+
+// WithRetryPolicy configures the client to retry transient failures according to the given policy.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return retryPolicyOption{p: p}
+}
+
+type rateLimitOption struct {
+	n   int
+	per time.Duration
+}
+
+func (r rateLimitOption) apply(opts *options) {
+	opts.rateLimit = r
+}
+
+// WithRateLimit limits the client to n requests per the given interval, e.g. WithRateLimit(300, time.Minute).
+// The limit is shared by every request made through the client and tightens automatically
+// when the X-RateLimit-Remaining header reports fewer remaining requests.
+func WithRateLimit(n int, per time.Duration) Option {
+	return rateLimitOption{n: n, per: per}
+}
+
+type middlewareOption []Middleware
+
+func (m middlewareOption) apply(opts *options) {
+	opts.middleware = append(opts.middleware, m...)
+}
+
+// WithMiddleware adds middleware around every request sent by the client.
+// Middleware runs in the order given, the first being the outermost.
+func WithMiddleware(mw ...Middleware) Option {
+	return middlewareOption(mw)
+}
+
+type callMiddlewareOption []Middleware
+
+func (m callMiddlewareOption) apply(opts *options) {
+	opts.callMW = append(opts.callMW, m...)
+}
+
+// WithCallMiddleware adds middleware around every call made by the client. Unlike WithMiddleware,
+// call middleware runs once per DoRequest, around all the attempts and rate limit waits of the call.
+// Middleware runs in the order given, the first being the outermost.
+func WithCallMiddleware(mw ...Middleware) Option {
+	return callMiddlewareOption(mw)
+}
+
 type requestOptions struct {
 	workspace   string
 	contentType string
+	response    *Response
 }
 
 // RequestOption represents functional options for configuring client requests.
@@ -77,3 +218,17 @@ func (c contentTypeOption) apply(opts *requestOptions) {
 func WithContentType(c string) RequestOption {
 	return contentTypeOption(c)
 }
+
+type responseCaptureOption struct {
+	r *Response
+}
+
+func (r responseCaptureOption) apply(opts *requestOptions) {
+	opts.response = r.r
+}
+
+// WithResponseCapture populates the given Response with the metadata of the api call's http response.
+// The Response is populated for error responses as well.
+func WithResponseCapture(r *Response) RequestOption {
+	return responseCaptureOption{r: r}
+}
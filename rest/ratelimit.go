@@ -0,0 +1,119 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket limiter shared by every request made through a Client.
+// It refills at a constant rate and additionally adapts to the rate limit headers
+// returned by the postman api.
+type rateLimiter struct {
+	mu          sync.Mutex
+	capacity    float64
+	tokens      float64
+	rate        float64 // tokens per second.
+	last        time.Time
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// newRateLimiter returns a limiter allowing n requests per the given interval.
+func newRateLimiter(n int, per time.Duration) *rateLimiter {
+	if n <= 0 || per <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{
+		capacity: float64(n),
+		tokens:   float64(n),
+		rate:     float64(n) / per.Seconds(),
+		last:     time.Now(),
+		now:      time.Now,
+	}
+}
+
+// wait blocks until a request may be made or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise it returns how long to wait before trying again.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	l.last = now
+	l.tokens += elapsed * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+}
+
+// observe adapts the limiter to the X-RateLimit-Remaining and X-RateLimit-Reset response headers,
+// so that requests made by other processes sharing the same api key are accounted for.
+func (l *rateLimiter) observe(h http.Header) {
+	if l == nil {
+		return
+	}
+
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining < 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+	if remaining == 0 {
+		if reset, ok := parseReset(h.Get("X-RateLimit-Reset"), now); ok && reset.After(l.pausedUntil) {
+			l.pausedUntil = reset
+		}
+	}
+}
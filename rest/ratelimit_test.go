@@ -0,0 +1,167 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		per     time.Duration
+		wantNil bool
+	}{
+		{
+			name:    "disabled",
+			n:       0,
+			per:     time.Minute,
+			wantNil: true,
+		},
+		{
+			name:    "invalid interval",
+			n:       10,
+			per:     0,
+			wantNil: true,
+		},
+		{
+			name:    "enabled",
+			n:       300,
+			per:     time.Minute,
+			wantNil: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newRateLimiter(tt.n, tt.per); (got == nil) != tt.wantNil {
+				t.Errorf("newRateLimiter() = %v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	l := newRateLimiter(2, time.Second)
+	l.last = now
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("reserve() burst %d got = %v, want 0", i, got)
+		}
+	}
+	if got := l.reserve(); got != 500*time.Millisecond {
+		t.Fatalf("reserve() empty bucket got = %v, want %v", got, 500*time.Millisecond)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if got := l.reserve(); got != 0 {
+		t.Fatalf("reserve() after refill got = %v, want 0", got)
+	}
+}
+
+func TestRateLimiter_observe(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{
+			name:   "no headers",
+			header: http.Header{},
+			want:   0,
+		},
+		{
+			name:   "plenty remaining",
+			header: http.Header{"X-Ratelimit-Remaining": []string{"100"}},
+			want:   0,
+		},
+		{
+			name:   "none remaining",
+			header: http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+			want:   time.Second / 10,
+		},
+		{
+			name: "none remaining until reset",
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"30"},
+			},
+			want: 30 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(10, time.Second)
+			l.last = now
+			l.now = func() time.Time { return now }
+
+			l.observe(tt.header)
+			if got := l.reserve(); got != tt.want {
+				t.Errorf("reserve() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_waitContextCanceled(t *testing.T) {
+	l := newRateLimiter(1, time.Hour)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wait() error got = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_DoRequestRateLimit(t *testing.T) {
+	var calls int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{}`))
+	})
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	c := NewClient("api-key", WithHTTPClient(srv.Client()), WithRateLimit(5, time.Second))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 7; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := c.NewRequest(context.Background(), http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if err = c.DoRequest(r, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 7 {
+		t.Fatalf("calls got = %v, want %v", got, 7)
+	}
+	// 5 requests fit in the burst, the remaining 2 need 400ms worth of tokens.
+	if elapsed := time.Since(start); elapsed < 350*time.Millisecond {
+		t.Fatalf("elapsed got = %v, want at least %v", elapsed, 350*time.Millisecond)
+	}
+}
@@ -0,0 +1,45 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response holds metadata about the http response of an api call.
+// Use WithResponseCapture to have it populated by DoRequest.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	RequestID  string
+	RateLimit  RateLimit
+	// Latency is the time spent sending the request and receiving the response headers,
+	// including any retries.
+	Latency time.Duration
+}
+
+// RateLimit holds the rate limit counters reported by the postman api.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func newResponse(resp *http.Response, latency time.Duration, now time.Time) Response {
+	return Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		RateLimit:  parseRateLimit(resp.Header, now),
+		Latency:    latency,
+	}
+}
+
+func parseRateLimit(h http.Header, now time.Time) RateLimit {
+	var rl RateLimit
+	rl.Limit, _ = strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	rl.Remaining, _ = strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	rl.Reset, _ = parseReset(h.Get("X-RateLimit-Reset"), now)
+	return rl
+}
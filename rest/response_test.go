@@ -0,0 +1,79 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_DoRequestResponseCapture(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			status:  http.StatusOK,
+			body:    `{"response":"here"}`,
+			wantErr: false,
+		},
+		{
+			name:    "error",
+			status:  http.StatusNotFound,
+			body:    `{"error":{"name":"instanceNotFoundError","message":"not found"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-123")
+				w.Header().Set("X-RateLimit-Limit", "300")
+				w.Header().Set("X-RateLimit-Remaining", "299")
+				w.Header().Set("X-RateLimit-Reset", "1664625600")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+			srv := httptest.NewServer(h)
+			t.Cleanup(srv.Close)
+
+			c := NewClient("api-key", WithHTTPClient(srv.Client()))
+			r, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var meta Response
+			var result map[string]string
+			if err = c.DoRequest(r, &result, WithResponseCapture(&meta)); (err != nil) != tt.wantErr {
+				t.Errorf("DoRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if meta.StatusCode != tt.status {
+				t.Errorf("StatusCode got = %v, want %v", meta.StatusCode, tt.status)
+			}
+			if meta.RequestID != "req-123" {
+				t.Errorf("RequestID got = %v, want %v", meta.RequestID, "req-123")
+			}
+			wantRateLimit := RateLimit{
+				Limit:     300,
+				Remaining: 299,
+				Reset:     time.Unix(1664625600, 0),
+			}
+			if !reflect.DeepEqual(meta.RateLimit, wantRateLimit) {
+				t.Errorf("RateLimit got = %v, want %v", meta.RateLimit, wantRateLimit)
+			}
+			if meta.Header.Get("X-Request-Id") != "req-123" {
+				t.Errorf("Header got = %v, want X-Request-Id header", meta.Header)
+			}
+			if meta.Latency <= 0 {
+				t.Errorf("Latency got = %v, want > 0", meta.Latency)
+			}
+		})
+	}
+}
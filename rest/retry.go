@@ -0,0 +1,227 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries requests that failed with a
+// transient error (network errors, 429 Too Many Requests and 5xx responses).
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request, including the first.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the time to wait before the first retry. Each following retry doubles the wait.
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed exponential backoff. When the server asks to wait longer than
+	// MaxBackoff, with the Retry-After or X-RateLimit-Reset headers, the request is not retried and
+	// the server response is returned instead.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0 to 1) of each computed backoff that is randomized.
+	Jitter float64
+	// RetryNonIdempotent allows retrying non-idempotent methods (POST, PATCH).
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for the postman api.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// attempts returns the number of attempts allowed for the given method.
+func (p RetryPolicy) attempts(method string) int {
+	if p.MaxAttempts < 2 {
+		return 1
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns how long to wait after the given (1 indexed) failed attempt.
+// Server provided delays take precedence over the computed exponential backoff. It returns false
+// when the server provided delay exceeds MaxBackoff, in which case the request must not be retried.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := retryAfter(resp, now); ok {
+			return d, p.MaxBackoff <= 0 || d <= p.MaxBackoff
+		}
+	}
+
+	d := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		d -= d * jitter * rand.Float64()
+	}
+
+	return time.Duration(d), true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry reports whether the outcome of an attempt is worth retrying.
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter reads the delay requested by the server from the Retry-After header or,
+// for rate limited responses, the X-RateLimit-Reset header.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset, ok := parseReset(resp.Header.Get("X-RateLimit-Reset"), now); ok {
+			return nonNegative(reset.Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+// parseReset parses a rate limit reset header, which may be either a unix timestamp
+// or a number of seconds until the reset.
+func parseReset(v string, now time.Time) (time.Time, bool) {
+	if v == "" {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	// Anything this large can only be an epoch timestamp.
+	if n > 1e9 {
+		return time.Unix(n, 0), true
+	}
+	return now.Add(time.Duration(n) * time.Second), true
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// send performs the http request, retrying according to the client's RetryPolicy.
+// Every attempt waits on the client's rate limiter, if one is configured.
+func (c *Client) send(r *http.Request) (*http.Response, error) {
+	attempts := c.retryPolicy.attempts(r.Method)
+	if attempts > 1 {
+		if err := rewindable(r); err != nil {
+			return nil, err
+		}
+	}
+
+	doer := chain(c.httpClient, c.middleware)
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		if err := c.limiter.wait(r.Context()); err != nil {
+			return nil, err
+		}
+
+		start := time.Now()
+		resp, err := doer.Do(r)
+		elapsed := time.Since(start)
+		a := Attempt{Number: attempt, Err: err, Duration: elapsed}
+		if err == nil {
+			c.limiter.observe(resp.Header)
+			a.StatusCode = resp.StatusCode
+		}
+		c.log(r, resp, err, attempt, elapsed)
+		observeAttempt(r.Context(), a)
+		if attempt >= attempts || !shouldRetry(r.Context(), resp, err) {
+			return resp, err
+		}
+
+		wait, ok := c.retryPolicy.backoff(attempt, resp, time.Now())
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return nil, r.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindable makes sure the request body can be replayed for subsequent attempts.
+func rewindable(r *http.Request) error {
+	if r.Body == nil || r.Body == http.NoBody || r.GetBody != nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	r.Body, _ = r.GetBody()
+
+	return nil
+}
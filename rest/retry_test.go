@@ -0,0 +1,277 @@
+// Package rest provides types/client for making requests to the postman REST api.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_DoRequestRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		Jitter:      0.5,
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		policy       RetryPolicy
+		statuses     []int
+		header       http.Header
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name:         "no retry policy",
+			method:       http.MethodGet,
+			policy:       RetryPolicy{},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "retries server errors until success",
+			method:       http.MethodGet,
+			policy:       policy,
+			statuses:     []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 3,
+			wantErr:      false,
+		},
+		{
+			name:         "gives up after max attempts",
+			method:       http.MethodDelete,
+			policy:       policy,
+			statuses:     []int{http.StatusInternalServerError},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "retries rate limited requests honoring retry-after",
+			method:       http.MethodGet,
+			policy:       policy,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			header:       http.Header{"Retry-After": []string{"0"}},
+			wantAttempts: 2,
+			wantErr:      false,
+		},
+		{
+			name:         "does not retry when retry-after exceeds max backoff",
+			method:       http.MethodGet,
+			policy:       policy,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			header:       http.Header{"Retry-After": []string{"60"}},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry client errors",
+			method:       http.MethodGet,
+			policy:       policy,
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry non idempotent methods",
+			method:       http.MethodPost,
+			policy:       policy,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:   "retries non idempotent methods when allowed",
+			method: http.MethodPost,
+			policy: RetryPolicy{
+				MaxAttempts:        3,
+				MinBackoff:         time.Millisecond,
+				RetryNonIdempotent: true,
+			},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 2,
+			wantErr:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&attempts, 1)
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if r.Method == http.MethodPost && string(body) != `{"message":"hello"}` {
+					t.Errorf("attempt %d body got = %s, want %s", n, body, `{"message":"hello"}`)
+				}
+
+				status := tt.statuses[len(tt.statuses)-1]
+				if int(n) <= len(tt.statuses) {
+					status = tt.statuses[n-1]
+				}
+				for k, v := range tt.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(status)
+				if status != http.StatusOK {
+					w.Write([]byte(`{"error":{"name":"serverError","message":"status ` + strconv.Itoa(status) + `"}}`))
+					return
+				}
+				w.Write([]byte(`{"response":"here"}`))
+			})
+			srv := httptest.NewServer(h)
+			t.Cleanup(srv.Close)
+
+			c := NewClient("api-key", WithHTTPClient(srv.Client()), WithRetryPolicy(tt.policy))
+
+			var body io.Reader
+			if tt.method == http.MethodPost {
+				// Use a reader without GetBody support to exercise body buffering.
+				body = io.MultiReader(bytes.NewBufferString(`{"message":"hello"}`))
+			}
+			r, err := http.NewRequest(tt.method, srv.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var result map[string]string
+			if err = c.DoRequest(r, &result); (err != nil) != tt.wantErr {
+				t.Errorf("DoRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(&attempts); got != tt.wantAttempts {
+				t.Errorf("attempts got = %v, want %v", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestClient_DoRequestRetryContextCanceled(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "20")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	c := NewClient("api-key", WithHTTPClient(srv.Client()), WithRetryPolicy(DefaultRetryPolicy()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	r, err := c.NewRequest(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	err = c.DoRequest(r, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DoRequest() error got = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("DoRequest() did not return on context cancellation")
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	p := RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+
+	tests := []struct {
+		name        string
+		attempt     int
+		resp        *http.Response
+		want        time.Duration
+		wantNoRetry bool
+	}{
+		{
+			name:    "exponential",
+			attempt: 3,
+			want:    4 * time.Second,
+		},
+		{
+			name:    "capped at max backoff",
+			attempt: 10,
+			want:    30 * time.Second,
+		},
+		{
+			name:    "retry-after seconds",
+			attempt: 1,
+			resp: &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Retry-After": []string{"30"}},
+			},
+			want: 30 * time.Second,
+		},
+		{
+			name:    "retry-after beyond max backoff",
+			attempt: 1,
+			resp: &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Retry-After": []string{"3600"}},
+			},
+			want:        time.Hour,
+			wantNoRetry: true,
+		},
+		{
+			name:    "retry-after http date",
+			attempt: 1,
+			resp: &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Retry-After": []string{now.Add(10 * time.Second).Format(http.TimeFormat)}},
+			},
+			want: 10 * time.Second,
+		},
+		{
+			name:    "rate limit reset epoch",
+			attempt: 1,
+			resp: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header: http.Header{
+					"X-Ratelimit-Reset": []string{strconv.FormatInt(now.Add(20*time.Second).Unix(), 10)},
+				},
+			},
+			want: 20 * time.Second,
+		},
+		{
+			name:    "rate limit reset seconds",
+			attempt: 1,
+			resp: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"X-Ratelimit-Reset": []string{"7"}},
+			},
+			want: 7 * time.Second,
+		},
+		{
+			name:    "rate limit reset ignored when not rate limited",
+			attempt: 1,
+			resp: &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Header:     http.Header{"X-Ratelimit-Reset": []string{"7"}},
+			},
+			want: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, retry := p.backoff(tt.attempt, tt.resp, now)
+			if got != tt.want || retry == tt.wantNoRetry {
+				t.Errorf("backoff() = %v, %v, want %v, %v", got, retry, tt.want, !tt.wantNoRetry)
+			}
+		})
+	}
+}
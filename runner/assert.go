@@ -0,0 +1,265 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/variables"
+)
+
+// AssertionKind is the kind of check made by an Assertion.
+type AssertionKind string
+
+// Possible values for assertion kinds.
+const (
+	// KindStatus checks the status code is Value, or between Min and Max.
+	KindStatus AssertionKind = "status"
+	// KindHeader checks the header named Target with Op.
+	KindHeader AssertionKind = "header"
+	// KindJSONPath checks the value at the json path Target of the body with Op.
+	KindJSONPath AssertionKind = "jsonpath"
+	// KindResponseTime checks the total time of the request, in milliseconds, is between Min and Max.
+	KindResponseTime AssertionKind = "responseTime"
+	// KindSchema checks the json body is valid against the json schema Schema.
+	KindSchema AssertionKind = "schema"
+)
+
+// Operator compares a header or json value of an Assertion.
+type Operator string
+
+// Possible values for operators.
+const (
+	OpEquals   Operator = "equals"
+	OpExists   Operator = "exists"
+	OpMatches  Operator = "matches"
+	OpContains Operator = "contains"
+)
+
+// Assertion is a declarative check of the response of an item. Assertions can be built with
+// the helpers below, or decoded from json, e.g. {"kind":"jsonpath","target":"$.id","op":"exists"}.
+type Assertion struct {
+	Name   string          `json:"name,omitempty"`
+	Kind   AssertionKind   `json:"kind"`
+	Target string          `json:"target,omitempty"`
+	Op     Operator        `json:"op,omitempty"`
+	Value  interface{}     `json:"value,omitempty"`
+	Min    *float64        `json:"min,omitempty"`
+	Max    *float64        `json:"max,omitempty"`
+	Schema json.RawMessage `json:"schema,omitempty"`
+}
+
+// AssertionResult is the result of an assertion for an execution. Error is empty when it passed.
+type AssertionResult struct {
+	Assertion Assertion `json:"assertion"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Passed reports whether the assertion passed.
+func (a AssertionResult) Passed() bool {
+	return a.Error == ""
+}
+
+// StatusIs asserts the status code is code.
+func StatusIs(code int) Assertion {
+	return Assertion{Name: fmt.Sprintf("status is %d", code), Kind: KindStatus, Value: code}
+}
+
+// StatusBetween asserts the status code is between min and max, inclusive.
+func StatusBetween(minCode, maxCode int) Assertion {
+	return Assertion{
+		Name: fmt.Sprintf("status is between %d and %d", minCode, maxCode),
+		Kind: KindStatus,
+		Min:  float(float64(minCode)),
+		Max:  float(float64(maxCode)),
+	}
+}
+
+// HeaderExists asserts the response has the header.
+func HeaderExists(name string) Assertion {
+	return Assertion{Name: fmt.Sprintf("has header %s", name), Kind: KindHeader, Target: name, Op: OpExists}
+}
+
+// HeaderEquals asserts the header has the value.
+func HeaderEquals(name, value string) Assertion {
+	return Assertion{
+		Name:   fmt.Sprintf("header %s is %s", name, value),
+		Kind:   KindHeader,
+		Target: name,
+		Op:     OpEquals,
+		Value:  value,
+	}
+}
+
+// HeaderMatches asserts the header matches the regular expression.
+func HeaderMatches(name, pattern string) Assertion {
+	return Assertion{
+		Name:   fmt.Sprintf("header %s matches %s", name, pattern),
+		Kind:   KindHeader,
+		Target: name,
+		Op:     OpMatches,
+		Value:  pattern,
+	}
+}
+
+// JSONPathExists asserts the json body has a value at path, e.g. "$.data.items[0].id".
+func JSONPathExists(path string) Assertion {
+	return Assertion{Name: fmt.Sprintf("%s exists", path), Kind: KindJSONPath, Target: path, Op: OpExists}
+}
+
+// JSONPathEquals asserts the value at path of the json body equals v, compared as json.
+func JSONPathEquals(path string, v interface{}) Assertion {
+	return Assertion{
+		Name:   fmt.Sprintf("%s equals %v", path, v),
+		Kind:   KindJSONPath,
+		Target: path,
+		Op:     OpEquals,
+		Value:  v,
+	}
+}
+
+// JSONPathMatches asserts the value at path of the json body matches the regular expression.
+func JSONPathMatches(path, pattern string) Assertion {
+	return Assertion{
+		Name:   fmt.Sprintf("%s matches %s", path, pattern),
+		Kind:   KindJSONPath,
+		Target: path,
+		Op:     OpMatches,
+		Value:  pattern,
+	}
+}
+
+// ResponseTimeBelow asserts the request took at most d.
+func ResponseTimeBelow(d time.Duration) Assertion {
+	return Assertion{
+		Name: fmt.Sprintf("response time is below %s", d),
+		Kind: KindResponseTime,
+		Max:  float(float64(d) / float64(time.Millisecond)),
+	}
+}
+
+// ResponseTimeBetween asserts the request took between minTime and maxTime.
+func ResponseTimeBetween(minTime, maxTime time.Duration) Assertion {
+	return Assertion{
+		Name: fmt.Sprintf("response time is between %s and %s", minTime, maxTime),
+		Kind: KindResponseTime,
+		Min:  float(float64(minTime) / float64(time.Millisecond)),
+		Max:  float(float64(maxTime) / float64(time.Millisecond)),
+	}
+}
+
+// MatchesSchema asserts the json body is valid against the json schema.
+func MatchesSchema(schema json.RawMessage) Assertion {
+	return Assertion{Name: "body matches schema", Kind: KindSchema, Schema: schema}
+}
+
+// Check returns an error when the execution doesn't satisfy the assertion.
+func (a Assertion) Check(e Execution) error {
+	if e.Response == nil {
+		return errors.New("no response")
+	}
+
+	switch a.Kind {
+	case KindStatus:
+		return checkRange("status", float64(e.Response.StatusCode), a.Value, a.Min, a.Max)
+	case KindHeader:
+		values := e.Response.Header.Values(a.Target)
+		if len(values) == 0 {
+			return fmt.Errorf("header %s is missing", a.Target)
+		}
+		return a.compare(fmt.Sprintf("header %s", a.Target), strings.Join(values, ", "))
+	case KindJSONPath:
+		var body interface{}
+		if err := json.Unmarshal(e.Response.Body, &body); err != nil {
+			return fmt.Errorf("body is not json: %w", err)
+		}
+		v, ok, err := evalJSONPath(body, a.Target)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%s doesn't exist", a.Target)
+		}
+		return a.compare(a.Target, v)
+	case KindResponseTime:
+		ms := float64(e.Timings.Total) / float64(time.Millisecond)
+		return checkRange("response time (ms)", ms, nil, a.Min, a.Max)
+	case KindSchema:
+		var body interface{}
+		if err := json.Unmarshal(e.Response.Body, &body); err != nil {
+			return fmt.Errorf("body is not json: %w", err)
+		}
+		return validateSchema(a.Schema, body)
+	default:
+		return fmt.Errorf("unknown assertion kind %q", a.Kind)
+	}
+}
+
+// compare checks the header or json value v with the operator of the assertion.
+func (a Assertion) compare(name string, v interface{}) error {
+	switch a.Op {
+	case OpExists:
+		return nil
+	case "", OpEquals:
+		if !jsonEqual(v, a.Value) {
+			return fmt.Errorf("%s is %v, want %v", name, v, a.Value)
+		}
+	case OpContains:
+		s, want := variables.String(v), variables.String(a.Value)
+		if !strings.Contains(s, want) {
+			return fmt.Errorf("%s is %v, want it to contain %v", name, v, want)
+		}
+	case OpMatches:
+		pattern := variables.String(a.Value)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if !re.MatchString(variables.String(v)) {
+			return fmt.Errorf("%s is %v, want it to match %s", name, v, pattern)
+		}
+	default:
+		return fmt.Errorf("unknown operator %q", a.Op)
+	}
+	return nil
+}
+
+// checkRange checks v equals want when it is set, and is between min and max.
+func checkRange(name string, v float64, want interface{}, minV, maxV *float64) error {
+	if want != nil && !jsonEqual(v, want) {
+		return fmt.Errorf("%s is %v, want %v", name, v, want)
+	}
+	if minV != nil && v < *minV {
+		return fmt.Errorf("%s is %v, want at least %v", name, v, *minV)
+	}
+	if maxV != nil && v > *maxV {
+		return fmt.Errorf("%s is %v, want at most %v", name, v, *maxV)
+	}
+	return nil
+}
+
+// jsonEqual compares the values as decoded json, so that e.g. int(1) equals float64(1).
+func jsonEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func normalize(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var n interface{}
+	if err = json.Unmarshal(b, &n); err != nil {
+		return v
+	}
+	return n
+}
+
+func float(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,86 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAssertion_Check(t *testing.T) {
+	execution := Execution{
+		Response: &Response{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
+			Body:       []byte(`{"data":{"id":7,"name":"Ada","tags":["a","b"],"address":{"city":"Paris"}}}`),
+		},
+		Timings: Timings{Total: 120 * time.Millisecond},
+	}
+	schema := []byte(`{
+		"type": "object",
+		"required": ["data"],
+		"properties": {
+			"data": {
+				"type": "object",
+				"required": ["id", "name"],
+				"properties": {
+					"id": {"type": "integer", "minimum": 1},
+					"name": {"type": "string", "pattern": "^[A-Z]"},
+					"tags": {"type": "array", "items": {"enum": ["a", "b"]}, "maxItems": 2}
+				}
+			}
+		}
+	}`)
+
+	tests := []struct {
+		name      string
+		assertion Assertion
+		wantErr   bool
+	}{
+		{name: "status", assertion: StatusIs(201)},
+		{name: "wrong status", assertion: StatusIs(200), wantErr: true},
+		{name: "status range", assertion: StatusBetween(200, 299)},
+		{name: "status out of range", assertion: StatusBetween(400, 499), wantErr: true},
+		{name: "header exists", assertion: HeaderExists("content-type")},
+		{name: "missing header", assertion: HeaderExists("X-Request-Id"), wantErr: true},
+		{name: "header equals", assertion: HeaderEquals("Content-Type", "text/plain"), wantErr: true},
+		{name: "header matches", assertion: HeaderMatches("Content-Type", "^application/json")},
+		{name: "json path exists", assertion: JSONPathExists("$.data.address.city")},
+		{name: "missing json path", assertion: JSONPathExists("$.data.address.zip"), wantErr: true},
+		{name: "json path equals number", assertion: JSONPathEquals("$.data.id", 7)},
+		{name: "json path equals array", assertion: JSONPathEquals("$.data.tags", []string{"a", "b"})},
+		{name: "json path index", assertion: JSONPathEquals("data.tags[-1]", "b")},
+		{name: "json path length", assertion: JSONPathEquals("$.data.tags.length", 2)},
+		{name: "json path bracket key", assertion: JSONPathEquals("$['data']['name']", "Ada")},
+		{name: "json path not equal", assertion: JSONPathEquals("$.data.name", "Bob"), wantErr: true},
+		{name: "json path matches", assertion: JSONPathMatches("$.data.name", "^A")},
+		{name: "invalid json path", assertion: JSONPathExists("$.data[x]"), wantErr: true},
+		{name: "response time", assertion: ResponseTimeBelow(time.Second)},
+		{name: "slow response", assertion: ResponseTimeBelow(100 * time.Millisecond), wantErr: true},
+		{name: "response time range", assertion: ResponseTimeBetween(150*time.Millisecond, time.Second), wantErr: true},
+		{name: "schema", assertion: MatchesSchema(schema)},
+		{
+			name:      "schema mismatch",
+			assertion: MatchesSchema([]byte(`{"properties":{"data":{"type":"array"}}}`)),
+			wantErr:   true,
+		},
+		{
+			name:      "schema oneOf",
+			assertion: MatchesSchema([]byte(`{"oneOf":[{"required":["data"]},{"type":"object"}]}`)),
+			wantErr:   true,
+		},
+		{name: "unknown kind", assertion: Assertion{Kind: "cookie"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.assertion.Check(execution); (err != nil) != tt.wantErr {
+				t.Errorf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := StatusIs(200).Check(Execution{Err: errors.New("refused")}); err == nil {
+		t.Error("Check() error got = nil, want error without a response")
+	}
+}
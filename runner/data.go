@@ -0,0 +1,71 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// IterationData holds one row of variables per iteration, e.g. read from a csv or json data file.
+type IterationData []map[string]string
+
+// ReadCSV reads iteration data from csv, where the first record holds the variable names.
+func ReadCSV(r io.Reader) (IterationData, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("runner: reading csv header: %w", err)
+	}
+
+	var data IterationData
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return data, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("runner: reading csv: %w", err)
+		}
+
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				row[name] = record[i]
+			}
+		}
+		data = append(data, row)
+	}
+}
+
+// ReadJSON reads iteration data from a json array of objects. Values which aren't strings are
+// kept as their json encoding, and null as an empty string.
+func ReadJSON(r io.Reader) (IterationData, error) {
+	var rows []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("runner: reading json: %w", err)
+	}
+
+	data := make(IterationData, 0, len(rows))
+	for _, raw := range rows {
+		row := make(map[string]string, len(raw))
+		for name, v := range raw {
+			var s string
+			if err := json.Unmarshal(v, &s); err == nil {
+				row[name] = s
+				continue
+			}
+			row[name] = string(v)
+		}
+		data = append(data, row)
+	}
+
+	return data, nil
+}
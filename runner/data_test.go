@@ -0,0 +1,34 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	got, err := ReadCSV(strings.NewReader("orderId,user\n1,ada\n2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := IterationData{{"orderId": "1", "user": "ada"}, {"orderId": "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadCSV() got = %v, want %v", got, want)
+	}
+}
+
+func TestReadJSON(t *testing.T) {
+	got, err := ReadJSON(strings.NewReader(`[{"orderId":1,"user":"ada","tags":["a"]},{"user":null}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := IterationData{{"orderId": "1", "user": "ada", "tags": `["a"]`}, {"user": ""}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadJSON() got = %v, want %v", got, want)
+	}
+
+	if _, err = ReadJSON(strings.NewReader(`{"orderId":1}`)); err == nil {
+		t.Error("ReadJSON() error got = nil, want error for an object")
+	}
+}
@@ -0,0 +1,100 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// evalJSONPath returns the value at path in the decoded json document v. Paths support
+// object keys (.key or ['key']) and array indexes ([0], [-1] for the last element), with
+// or without the leading "$", e.g. "$.data.items[0].id" or "data.items[0].id".
+func evalJSONPath(v interface{}, path string) (interface{}, bool, error) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, step := range steps {
+		switch current := v.(type) {
+		case map[string]interface{}:
+			if step.isIndex {
+				return nil, false, nil
+			}
+			next, ok := current[step.key]
+			if !ok {
+				return nil, false, nil
+			}
+			v = next
+		case []interface{}:
+			if !step.isIndex {
+				if step.key == "length" {
+					v = float64(len(current))
+					continue
+				}
+				return nil, false, nil
+			}
+			i := step.index
+			if i < 0 {
+				i += len(current)
+			}
+			if i < 0 || i >= len(current) {
+				return nil, false, nil
+			}
+			v = current[i]
+		default:
+			return nil, false, nil
+		}
+	}
+
+	return v, true, nil
+}
+
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	p := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	var steps []jsonPathStep
+
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+			end := strings.IndexAny(p, ".[")
+			if end == -1 {
+				end = len(p)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("runner: invalid json path %q", path)
+			}
+			steps = append(steps, jsonPathStep{key: p[:end]})
+			p = p[end:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("runner: invalid json path %q", path)
+			}
+			inner := strings.TrimSpace(p[1:end])
+			p = p[end+1:]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				steps = append(steps, jsonPathStep{key: inner[1 : len(inner)-1]})
+				continue
+			}
+			i, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("runner: invalid json path index %q in %q", inner, path)
+			}
+			steps = append(steps, jsonPathStep{index: i, isIndex: true})
+		default:
+			// A leading key without a dot, e.g. "data.id".
+			p = "." + p
+		}
+	}
+
+	return steps, nil
+}
@@ -0,0 +1,115 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"net/http"
+
+	"github.com/actatum/postman-client/environments"
+)
+
+type options struct {
+	httpClient  *http.Client
+	environment environments.Environment
+	globals     map[string]string
+	data        IterationData
+	iterations  int
+	assertions  map[string][]Assertion
+	translate   bool
+}
+
+// Option represents functional options for configuring the Runner.
+type Option interface {
+	apply(*options)
+}
+
+type httpClientOption struct {
+	c *http.Client
+}
+
+func (h httpClientOption) apply(opts *options) {
+	if h.c != nil {
+		opts.httpClient = h.c
+	}
+}
+
+// WithHTTPClient configures the http client sending the requests. Defaults to http.DefaultClient.
+func WithHTTPClient(c *http.Client) Option {
+	return httpClientOption{c: c}
+}
+
+type environmentOption environments.Environment
+
+func (e environmentOption) apply(opts *options) {
+	opts.environment = environments.Environment(e)
+}
+
+// WithEnvironment configures the environment of the run. Values which aren't enabled are ignored.
+func WithEnvironment(env environments.Environment) Option {
+	return environmentOption(env)
+}
+
+type globalsOption map[string]string
+
+func (g globalsOption) apply(opts *options) {
+	opts.globals = g
+}
+
+// WithGlobals configures the global variables of the run.
+func WithGlobals(globals map[string]string) Option {
+	return globalsOption(globals)
+}
+
+type iterationDataOption IterationData
+
+func (d iterationDataOption) apply(opts *options) {
+	opts.data = IterationData(d)
+}
+
+// WithIterationData configures the data of each iteration. The collection runs once per row
+// unless WithIterations is given, in which case the rows are reused cyclically.
+func WithIterationData(data IterationData) Option {
+	return iterationDataOption(data)
+}
+
+type iterationsOption int
+
+func (i iterationsOption) apply(opts *options) {
+	if i > 0 {
+		opts.iterations = int(i)
+	}
+}
+
+// WithIterations configures the number of times the collection runs. Defaults to 1.
+func WithIterations(n int) Option {
+	return iterationsOption(n)
+}
+
+type assertionsOption struct {
+	key        string
+	assertions []Assertion
+}
+
+func (a assertionsOption) apply(opts *options) {
+	if opts.assertions == nil {
+		opts.assertions = map[string][]Assertion{}
+	}
+	opts.assertions[a.key] = append(opts.assertions[a.key], a.assertions...)
+}
+
+// WithAssertions attaches assertions to the item with the id key, or to the item whose path, the names
+// of its parent folders and its own name joined by "/", is key. The option can be given several times.
+func WithAssertions(key string, assertions ...Assertion) Option {
+	return assertionsOption{key: key, assertions: assertions}
+}
+
+type scriptTranslationOption bool
+
+func (t scriptTranslationOption) apply(opts *options) {
+	opts.translate = bool(t)
+}
+
+// WithScriptTranslation checks the assertions translated from the test scripts of the collection,
+// its folders and items, in addition to the ones given with WithAssertions. See Translate.
+func WithScriptTranslation() Option {
+	return scriptTranslationOption(true)
+}
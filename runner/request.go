@@ -0,0 +1,319 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/variables"
+)
+
+// rawContentTypes maps the languages of raw bodies to their content type.
+var rawContentTypes = map[string]string{
+	"json":       "application/json",
+	"xml":        "application/xml",
+	"html":       "text/html",
+	"javascript": "application/javascript",
+	"text":       "text/plain",
+}
+
+// buildRequest returns the http request for req, resolving variables with s and authenticating
+// with auth, the auth inherited by the item.
+func buildRequest(
+	ctx context.Context,
+	req *collections.Request,
+	auth *collections.Auth,
+	s *resolver,
+) (*http.Request, []byte, error) {
+	method := strings.ToUpper(s.resolve(req.Method))
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	u, err := buildURL(req.URL, s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := make(http.Header)
+	for _, h := range req.Headers {
+		if h.Disabled {
+			continue
+		}
+		header.Add(s.resolve(h.Key), s.resolve(h.Value))
+	}
+
+	body, contentType, err := buildBody(req.Body, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	if contentType != "" && header.Get("Content-Type") == "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	if err = applyAuth(auth, u, header, s); err != nil {
+		return nil, nil, err
+	}
+
+	r, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	if body == nil {
+		r.Body = http.NoBody
+		r.ContentLength = 0
+	}
+	r.Header = header
+	if host := header.Get("Host"); host != "" {
+		r.Host = host
+	}
+
+	return r, body, nil
+}
+
+// buildURL returns the resolved url, with the path variables replaced.
+func buildURL(u *collections.URL, s *resolver) (*url.URL, error) {
+	if u == nil {
+		return nil, fmt.Errorf("runner: request has no url")
+	}
+
+	raw := u.Raw
+	if raw == "" {
+		raw = rawURL(u)
+	}
+	raw = s.resolve(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("runner: invalid url %q: %w", raw, err)
+	}
+
+	if len(u.Variables) > 0 {
+		segments := strings.Split(parsed.Path, "/")
+		for i, seg := range segments {
+			if !strings.HasPrefix(seg, ":") {
+				continue
+			}
+			for _, v := range u.Variables {
+				if !v.Disabled && v.Key == seg[1:] {
+					segments[i] = s.resolve(variables.String(v.Value))
+				}
+			}
+		}
+		parsed.Path = strings.Join(segments, "/")
+		parsed.RawPath = ""
+	}
+
+	return parsed, nil
+}
+
+// rawURL assembles a url from its parts when the raw url is missing.
+func rawURL(u *collections.URL) string {
+	var b strings.Builder
+	if u.Protocol != "" {
+		b.WriteString(u.Protocol + "://")
+	}
+	b.WriteString(strings.Join(u.Host, "."))
+	if u.Port != "" {
+		b.WriteString(":" + u.Port)
+	}
+	if len(u.Path) > 0 {
+		b.WriteString("/" + strings.Join(u.Path, "/"))
+	}
+
+	sep := "?"
+	for _, q := range u.Query {
+		if q.Disabled {
+			continue
+		}
+		b.WriteString(sep + q.Key + "=" + q.Value)
+		sep = "&"
+	}
+	if u.Hash != "" {
+		b.WriteString("#" + u.Hash)
+	}
+
+	return b.String()
+}
+
+// buildBody returns the resolved body and its content type.
+func buildBody(body *collections.Body, s *resolver) ([]byte, string, error) {
+	if body == nil || body.Disabled {
+		return nil, "", nil
+	}
+
+	switch body.Mode {
+	case collections.BodyModeRaw:
+		return []byte(s.resolve(body.Raw)), rawContentTypes[rawLanguage(body)], nil
+	case collections.BodyModeURLEncoded:
+		values := url.Values{}
+		for _, p := range body.URLEncoded {
+			if !p.Disabled {
+				values.Add(s.resolve(p.Key), s.resolve(p.Value))
+			}
+		}
+		return []byte(values.Encode()), "application/x-www-form-urlencoded", nil
+	case collections.BodyModeFormData:
+		return buildFormData(body.FormData, s)
+	case collections.BodyModeFile:
+		if body.File == nil {
+			return nil, "", nil
+		}
+		if body.File.Content != "" {
+			return []byte(body.File.Content), "", nil
+		}
+		b, err := os.ReadFile(s.resolve(body.File.Src))
+		return b, "", err
+	case collections.BodyModeGraphQL:
+		if body.GraphQL == nil {
+			return nil, "", nil
+		}
+		payload := map[string]interface{}{"query": s.resolve(body.GraphQL.Query)}
+		if vars := s.resolve(body.GraphQL.Variables); strings.TrimSpace(vars) != "" {
+			payload["variables"] = json.RawMessage(vars)
+		}
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, "", fmt.Errorf("runner: invalid graphql variables: %w", err)
+		}
+		return b, "application/json", nil
+	default:
+		return nil, "", nil
+	}
+}
+
+// rawLanguage returns the language of a raw body from its options, e.g. {"raw":{"language":"json"}}.
+func rawLanguage(body *collections.Body) string {
+	raw, ok := body.Options["raw"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	language, _ := raw["language"].(string)
+	return language
+}
+
+func buildFormData(params []collections.FormParam, s *resolver) ([]byte, string, error) {
+	var b bytes.Buffer
+	mw := multipart.NewWriter(&b)
+
+	for _, p := range params {
+		if p.Disabled {
+			continue
+		}
+		key := s.resolve(p.Key)
+
+		if p.Type != collections.FormParamTypeFile {
+			if p.ContentType == "" {
+				if err := mw.WriteField(key, s.resolve(p.Value)); err != nil {
+					return nil, "", err
+				}
+				continue
+			}
+			h := make(textproto.MIMEHeader)
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q`, key))
+			h.Set("Content-Type", p.ContentType)
+			w, err := mw.CreatePart(h)
+			if err != nil {
+				return nil, "", err
+			}
+			if _, err = io.WriteString(w, s.resolve(p.Value)); err != nil {
+				return nil, "", err
+			}
+			continue
+		}
+
+		for _, src := range fileSources(p.Src) {
+			src = s.resolve(src)
+			content, err := os.ReadFile(src)
+			if err != nil {
+				return nil, "", err
+			}
+			w, err := mw.CreateFormFile(key, filepath.Base(src))
+			if err != nil {
+				return nil, "", err
+			}
+			if _, err = w.Write(content); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, "", err
+	}
+	return b.Bytes(), mw.FormDataContentType(), nil
+}
+
+// fileSources returns the file paths of a form data parameter, a string or an array of strings.
+func fileSources(src interface{}) []string {
+	switch src := src.(type) {
+	case string:
+		if src == "" {
+			return nil
+		}
+		return []string{src}
+	case []interface{}:
+		sources := make([]string, 0, len(src))
+		for _, v := range src {
+			if s, ok := v.(string); ok && s != "" {
+				sources = append(sources, s)
+			}
+		}
+		return sources
+	default:
+		return nil
+	}
+}
+
+// applyAuth authenticates the request with the bearer, basic or api key auth types.
+func applyAuth(auth *collections.Auth, u *url.URL, header http.Header, s *resolver) error {
+	if auth == nil {
+		return nil
+	}
+
+	attr := func(key string) string {
+		for _, a := range auth.Attributes() {
+			if a.Key == key {
+				return s.resolve(variables.String(a.Value))
+			}
+		}
+		return ""
+	}
+
+	switch auth.Type {
+	case "", collections.AuthTypeNoAuth:
+	case collections.AuthTypeBearer:
+		header.Set("Authorization", "Bearer "+attr("token"))
+	case collections.AuthTypeBasic:
+		credentials := base64.StdEncoding.EncodeToString([]byte(attr("username") + ":" + attr("password")))
+		header.Set("Authorization", "Basic "+credentials)
+	case collections.AuthTypeAPIKey:
+		key, value := attr("key"), attr("value")
+		if attr("in") == "query" {
+			q := u.Query()
+			q.Set(key, value)
+			u.RawQuery = q.Encode()
+			return nil
+		}
+		header.Set(key, value)
+	default:
+		return fmt.Errorf("runner: unsupported auth type %q", auth.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"net/http"
+	"time"
+)
+
+// Result is the result of a collection run.
+type Result struct {
+	Collection string      `json:"collection"`
+	Started    time.Time   `json:"started"`
+	Completed  time.Time   `json:"completed"`
+	Iterations []Iteration `json:"iterations"`
+}
+
+// Executions returns the executions of every iteration, in order.
+func (r *Result) Executions() []Execution {
+	var executions []Execution
+	for _, it := range r.Iterations {
+		executions = append(executions, it.Executions...)
+	}
+	return executions
+}
+
+// Passed reports whether every execution passed.
+func (r *Result) Passed() bool {
+	for _, e := range r.Executions() {
+		if !e.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Iteration is a single run of the collection, with one row of iteration data.
+type Iteration struct {
+	Index      int               `json:"index"`
+	Data       map[string]string `json:"data,omitempty"`
+	Executions []Execution       `json:"executions"`
+}
+
+// Execution is the result of sending the request of an item.
+type Execution struct {
+	ItemID string `json:"itemId,omitempty"`
+	// Path holds the names of the parent folders followed by the name of the item.
+	Path     []string      `json:"path"`
+	Request  RequestRecord `json:"request"`
+	Response *Response     `json:"response,omitempty"`
+	Timings  Timings       `json:"timings"`
+	Err      error         `json:"-"`
+	// Unresolved holds the names of the variables referenced by the request which couldn't be resolved.
+	Unresolved []string `json:"unresolved,omitempty"`
+	// Assertions are the results of the assertions of the item.
+	Assertions []AssertionResult `json:"assertions,omitempty"`
+	// Untranslated holds the statements of the test scripts which couldn't be translated to assertions.
+	Untranslated []string `json:"untranslated,omitempty"`
+}
+
+// Passed reports whether the request was sent and every assertion passed.
+func (e Execution) Passed() bool {
+	if e.Err != nil {
+		return false
+	}
+	for _, a := range e.Assertions {
+		if !a.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the name of the executed item.
+func (e Execution) Name() string {
+	if len(e.Path) == 0 {
+		return ""
+	}
+	return e.Path[len(e.Path)-1]
+}
+
+// RequestRecord is the request sent for an execution, after variables were resolved.
+type RequestRecord struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+// Response is the response received for an execution.
+type Response struct {
+	StatusCode int         `json:"code"`
+	Status     string      `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+}
+
+// Timings are the timings of an execution. Phases which didn't happen, e.g. the dns lookup
+// of a reused connection, are zero.
+type Timings struct {
+	Started   time.Time     `json:"started"`
+	DNS       time.Duration `json:"dns"`
+	Connect   time.Duration `json:"connect"`
+	TLS       time.Duration `json:"tls"`
+	FirstByte time.Duration `json:"firstByte"`
+	Total     time.Duration `json:"total"`
+}
@@ -0,0 +1,226 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+//
+// Items are run depth first in collection order, once per iteration. {{variable}} references
+// are resolved with the variables package, from the globals, collection variables, environment
+// and iteration data, each overriding the previous one. Pre-request and test scripts are not
+// executed, but the responses can be checked with declarative assertions, given with
+// WithAssertions or translated from the test scripts with WithScriptTranslation.
+//
+//	r := runner.New(runner.WithEnvironment(env), runner.WithHTTPClient(client))
+//	result, err := r.Run(ctx, collection)
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"time"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/environments"
+	"github.com/actatum/postman-client/variables"
+)
+
+// Runner runs collections.
+type Runner struct {
+	httpClient  *http.Client
+	environment []environments.EnvironmentValue
+	globals     map[string]string
+	data        IterationData
+	iterations  int
+	assertions  map[string][]Assertion
+	translate   bool
+}
+
+// New returns a new instance of Runner.
+func New(opts ...Option) *Runner {
+	options := options{
+		httpClient: http.DefaultClient,
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	iterations := options.iterations
+	if iterations == 0 {
+		iterations = 1
+		if len(options.data) > 0 {
+			iterations = len(options.data)
+		}
+	}
+
+	return &Runner{
+		httpClient:  options.httpClient,
+		environment: options.environment.Values,
+		globals:     options.globals,
+		data:        options.data,
+		iterations:  iterations,
+		assertions:  options.assertions,
+		translate:   options.translate,
+	}
+}
+
+// Run runs the collection. Failed requests are reported in the executions of the result;
+// the returned error is only set when ctx is done, along with the partial result.
+func (r *Runner) Run(ctx context.Context, collection collections.CollectionDetails) (*Result, error) {
+	result := &Result{
+		Collection: collection.Info.Name,
+		Started:    time.Now(),
+	}
+	defer func() {
+		result.Completed = time.Now()
+	}()
+
+	for i := 0; i < r.iterations; i++ {
+		var data map[string]string
+		if len(r.data) > 0 {
+			data = r.data[i%len(r.data)]
+		}
+
+		vars := variables.New()
+		vars.SetAll(variables.Global, r.globals)
+		vars.SetCollection(collection.Variables)
+		vars.SetEnvironment(r.environment)
+		vars.SetAll(variables.Data, data)
+
+		iteration := Iteration{Index: i, Data: data}
+		err := r.runItems(ctx, collection.Items, nil, collection.Auth, collection.Events, vars, &iteration)
+		result.Iterations = append(result.Iterations, iteration)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// runItems runs the items depth first. path holds the names of the parent folders, and events
+// the events of the collection and parent folders.
+func (r *Runner) runItems(
+	ctx context.Context,
+	items []collections.Item,
+	path []string,
+	auth *collections.Auth,
+	events []collections.Event,
+	vars *variables.Resolver,
+	iteration *Iteration,
+) error {
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		itemAuth := auth
+		if item.Auth != nil {
+			itemAuth = item.Auth
+		}
+		itemPath := append(append([]string{}, path...), item.Name)
+		itemEvents := append(append([]collections.Event{}, events...), item.Events...)
+
+		if item.IsFolder() {
+			if err := r.runItems(ctx, item.Items, itemPath, itemAuth, itemEvents, vars, iteration); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if item.Request.Auth != nil {
+			itemAuth = item.Request.Auth
+		}
+		execution := r.execute(ctx, item, itemPath, itemAuth, vars)
+		r.check(&execution, itemEvents)
+		iteration.Executions = append(iteration.Executions, execution)
+	}
+
+	return nil
+}
+
+// execute sends the request of the item.
+func (r *Runner) execute(
+	ctx context.Context,
+	item collections.Item,
+	path []string,
+	auth *collections.Auth,
+	vars *variables.Resolver,
+) Execution {
+	execution := Execution{
+		ItemID: item.ID,
+		Path:   path,
+	}
+
+	var timings Timings
+	var dnsStart, connectStart, tlsStart time.Time
+	trace := &httptrace.ClientTrace{
+		DNSStart:          func(httptrace.DNSStartInfo) { dnsStart = time.Now() },
+		DNSDone:           func(httptrace.DNSDoneInfo) { timings.DNS = time.Since(dnsStart) },
+		ConnectStart:      func(string, string) { connectStart = time.Now() },
+		ConnectDone:       func(string, string, error) { timings.Connect = time.Since(connectStart) },
+		TLSHandshakeStart: func() { tlsStart = time.Now() },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { timings.TLS = time.Since(tlsStart) },
+		GotFirstResponseByte: func() {
+			timings.FirstByte = time.Since(timings.Started)
+		},
+	}
+
+	s := &resolver{vars: vars}
+	req, body, err := buildRequest(httptrace.WithClientTrace(ctx, trace), item.Request, auth, s)
+	execution.Unresolved = s.unresolved
+	if err != nil {
+		execution.Err = err
+		return execution
+	}
+	execution.Request = RequestRecord{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	}
+
+	timings.Started = time.Now()
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		timings.Total = time.Since(timings.Started)
+		execution.Timings = timings
+		execution.Err = err
+		return execution
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	timings.Total = time.Since(timings.Started)
+	execution.Timings = timings
+	execution.Response = &Response{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       respBody,
+	}
+	execution.Err = err
+
+	return execution
+}
+
+// check runs the assertions of the executed item, including the ones translated from events.
+func (r *Runner) check(execution *Execution, events []collections.Event) {
+	assertions := append([]Assertion{}, r.assertions[execution.ItemID]...)
+	if key := strings.Join(execution.Path, "/"); key != execution.ItemID {
+		assertions = append(assertions, r.assertions[key]...)
+	}
+	if r.translate {
+		translated, untranslated := TranslateEvents(events)
+		assertions = append(assertions, translated...)
+		execution.Untranslated = untranslated
+	}
+
+	for _, a := range assertions {
+		result := AssertionResult{Assertion: a}
+		if err := a.Check(*execution); err != nil {
+			result.Error = err.Error()
+		}
+		execution.Assertions = append(execution.Assertions, result)
+	}
+}
@@ -0,0 +1,269 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/environments"
+)
+
+func TestRunner_Run(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"method":        r.Method,
+			"path":          r.URL.Path,
+			"query":         r.URL.RawQuery,
+			"authorization": r.Header.Get("Authorization"),
+			"contentType":   r.Header.Get("Content-Type"),
+			"body":          string(body),
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	collection := collections.CollectionDetails{
+		Info: collections.Info{Name: "Orders"},
+		Variables: []collections.Variable{
+			{Key: "baseUrl", Value: "http://unused"},
+			{Key: "token", Value: "collection-token"},
+		},
+		Auth: &collections.Auth{
+			Type:   collections.AuthTypeBearer,
+			Bearer: []collections.AuthAttribute{{Key: "token", Value: "{{token}}"}},
+		},
+		Items: []collections.Item{
+			{
+				Name: "Orders",
+				Items: []collections.Item{
+					{
+						ID:   "get-order",
+						Name: "Get order",
+						Request: &collections.Request{
+							Method: http.MethodGet,
+							URL: &collections.URL{
+								Raw:       "{{baseUrl}}/orders/:id?expand={{expand}}",
+								Variables: []collections.Variable{{Key: "id", Value: "{{orderId}}"}},
+							},
+						},
+					},
+					{
+						Name: "Create order",
+						Request: &collections.Request{
+							Method: http.MethodPost,
+							URL:    &collections.URL{Raw: "{{baseUrl}}/orders"},
+							Auth:   &collections.Auth{Type: collections.AuthTypeNoAuth},
+							Body: &collections.Body{
+								Mode:    collections.BodyModeRaw,
+								Raw:     `{"id":"{{orderId}}","missing":"{{missing}}"}`,
+								Options: map[string]interface{}{"raw": map[string]interface{}{"language": "json"}},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "Login",
+				Request: &collections.Request{
+					Method: http.MethodPost,
+					URL: &collections.URL{
+						Host: collections.Segments{"{{baseUrl}}"},
+						Path: collections.Segments{"login"},
+						Query: []collections.QueryParam{
+							{Key: "debug", Value: "1", Disabled: true},
+							{Key: "user", Value: "{{user}}"},
+						},
+					},
+					Body: &collections.Body{
+						Mode: collections.BodyModeURLEncoded,
+						URLEncoded: []collections.URLEncodedParam{
+							{Key: "user", Value: "{{user}}"},
+							{Key: "password", Value: "secret", Disabled: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	r := New(
+		WithHTTPClient(srv.Client()),
+		WithGlobals(map[string]string{"expand": "items", "user": "global"}),
+		WithEnvironment(environments.Environment{Values: []environments.EnvironmentValue{
+			{Key: "baseUrl", Value: srv.URL, Enabled: true},
+			{Key: "token", Value: "disabled-token"},
+		}}),
+		WithIterationData(IterationData{
+			{"orderId": "1", "user": "ada"},
+			{"orderId": "2", "user": "grace"},
+		}),
+	)
+	result, err := r.Run(context.Background(), collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Collection != "Orders" || len(result.Iterations) != 2 {
+		t.Fatalf("Run() got = %+v, want 2 iterations of Orders", result)
+	}
+
+	type echo struct {
+		Method        string `json:"method"`
+		Path          string `json:"path"`
+		Query         string `json:"query"`
+		Authorization string `json:"authorization"`
+		ContentType   string `json:"contentType"`
+		Body          string `json:"body"`
+	}
+	var got []echo
+	for _, e := range result.Executions() {
+		if e.Err != nil {
+			t.Fatalf("Execution %v error = %v", e.Path, e.Err)
+		}
+		if e.Timings.Total <= 0 || e.Timings.FirstByte <= 0 {
+			t.Errorf("Execution %v timings got = %+v, want total and first byte", e.Path, e.Timings)
+		}
+		var ech echo
+		if err = json.Unmarshal(e.Response.Body, &ech); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, ech)
+	}
+
+	bearer := "Bearer collection-token"
+	want := []echo{
+		{Method: http.MethodGet, Path: "/orders/1", Query: "expand=items", Authorization: bearer},
+		{Method: http.MethodPost, Path: "/orders", ContentType: "application/json",
+			Body: `{"id":"1","missing":"{{missing}}"}`},
+		{Method: http.MethodPost, Path: "/login", Query: "user=ada", Authorization: bearer,
+			ContentType: "application/x-www-form-urlencoded", Body: "user=ada"},
+		{Method: http.MethodGet, Path: "/orders/2", Query: "expand=items", Authorization: bearer},
+		{Method: http.MethodPost, Path: "/orders", ContentType: "application/json",
+			Body: `{"id":"2","missing":"{{missing}}"}`},
+		{Method: http.MethodPost, Path: "/login", Query: "user=grace", Authorization: bearer,
+			ContentType: "application/x-www-form-urlencoded", Body: "user=grace"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() requests got = %+v, want %+v", got, want)
+	}
+
+	first := result.Iterations[0].Executions[0]
+	if !reflect.DeepEqual(first.Path, []string{"Orders", "Get order"}) || first.Name() != "Get order" {
+		t.Errorf("Execution path got = %v, want [Orders Get order]", first.Path)
+	}
+	if first.ItemID != "get-order" || first.Request.URL != srv.URL+"/orders/1?expand=items" {
+		t.Errorf("Execution got = %+v, want item id and resolved url", first)
+	}
+	if unresolved := result.Iterations[0].Executions[1].Unresolved; !reflect.DeepEqual(unresolved, []string{"missing"}) {
+		t.Errorf("Execution unresolved got = %v, want [missing]", unresolved)
+	}
+}
+
+func TestRunner_RunErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	t.Cleanup(srv.Close)
+
+	collection := collections.CollectionDetails{
+		Items: []collections.Item{
+			{Name: "No url", Request: &collections.Request{}},
+			{Name: "Digest", Request: &collections.Request{
+				URL:  &collections.URL{Raw: srv.URL},
+				Auth: &collections.Auth{Type: collections.AuthTypeDigest},
+			}},
+			{Name: "Teapot", Request: &collections.Request{URL: &collections.URL{Raw: srv.URL}}},
+		},
+	}
+
+	result, err := New(WithHTTPClient(srv.Client())).Run(context.Background(), collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	executions := result.Executions()
+	if len(executions) != 3 {
+		t.Fatalf("Run() got %d executions, want 3", len(executions))
+	}
+	if executions[0].Err == nil || executions[1].Err == nil ||
+		!strings.Contains(executions[1].Err.Error(), "digest") {
+		t.Errorf("Run() errors got = %v, %v, want url and auth errors", executions[0].Err, executions[1].Err)
+	}
+	if executions[2].Err != nil || executions[2].Response.StatusCode != http.StatusTeapot {
+		t.Errorf("Run() got = %+v, want a 418 response", executions[2])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = New().Run(ctx, collection); err != context.Canceled {
+		t.Errorf("Run() error got = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRunner_RunAssertions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":1,"status":"paid"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	collection := collections.CollectionDetails{
+		Events: []collections.Event{{
+			Listen: collections.EventListenTest,
+			Script: collections.Script{Exec: []string{`pm.response.to.have.status(200);`}},
+		}},
+		Items: []collections.Item{
+			{
+				Name: "Orders",
+				Items: []collections.Item{
+					{
+						ID:   "get-order",
+						Name: "Get order",
+						Events: []collections.Event{{
+							Listen: collections.EventListenTest,
+							Script: collections.Script{Exec: []string{
+								`var jsonData = pm.response.json();`,
+								`pm.expect(jsonData.status).to.eql("refunded");`,
+								`postman.setNextRequest(null);`,
+							}},
+						}},
+						Request: &collections.Request{URL: &collections.URL{Raw: srv.URL}},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := New(
+		WithHTTPClient(srv.Client()),
+		WithAssertions("get-order", JSONPathExists("$.id")),
+		WithAssertions("Orders/Get order", HeaderEquals("Content-Type", "application/json")),
+		WithScriptTranslation(),
+	).Run(context.Background(), collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	execution := result.Executions()[0]
+	var passed []bool
+	for _, a := range execution.Assertions {
+		passed = append(passed, a.Passed())
+	}
+	if want := []bool{true, true, true, false}; !reflect.DeepEqual(passed, want) {
+		t.Errorf("Execution assertions got = %+v, want passed %v", execution.Assertions, want)
+	}
+	if want := []string{"postman.setNextRequest(null)"}; !reflect.DeepEqual(execution.Untranslated, want) {
+		t.Errorf("Execution untranslated got = %q, want %q", execution.Untranslated, want)
+	}
+	if result.Passed() {
+		t.Error("Result.Passed() got = true, want false")
+	}
+}
@@ -0,0 +1,234 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// schema is the subset of json schema supported by schema assertions: type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems, minLength, maxLength,
+// pattern, minimum, maximum, allOf, anyOf and oneOf.
+type schema struct {
+	Type                 interface{}        `json:"type"`
+	Enum                 []interface{}      `json:"enum"`
+	Const                *interface{}       `json:"const"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties *bool              `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	MinItems             *int               `json:"minItems"`
+	MaxItems             *int               `json:"maxItems"`
+	MinLength            *int               `json:"minLength"`
+	MaxLength            *int               `json:"maxLength"`
+	Pattern              string             `json:"pattern"`
+	Minimum              *float64           `json:"minimum"`
+	Maximum              *float64           `json:"maximum"`
+	AllOf                []*schema          `json:"allOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+	OneOf                []*schema          `json:"oneOf"`
+}
+
+// validateSchema validates the decoded json document v against the json schema s.
+func validateSchema(s json.RawMessage, v interface{}) error {
+	var sc schema
+	if err := json.Unmarshal(s, &sc); err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	return sc.validate("$", v)
+}
+
+func (s *schema) validate(path string, v interface{}) error {
+	if s == nil {
+		return nil
+	}
+
+	if err := s.validateType(path, v); err != nil {
+		return err
+	}
+	if len(s.Enum) > 0 && !containsValue(s.Enum, v) {
+		return fmt.Errorf("%s: %v is not one of %v", path, v, s.Enum)
+	}
+	if s.Const != nil && !reflect.DeepEqual(*s.Const, v) {
+		return fmt.Errorf("%s: %v is not %v", path, v, *s.Const)
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if err := s.validateObject(path, v); err != nil {
+			return err
+		}
+	case []interface{}:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			return fmt.Errorf("%s: %d items, want at least %d", path, len(v), *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			return fmt.Errorf("%s: %d items, want at most %d", path, len(v), *s.MaxItems)
+		}
+		for i, item := range v {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	case string:
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			return fmt.Errorf("%s: length %d, want at least %d", path, n, *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			return fmt.Errorf("%s: length %d, want at most %d", path, n, *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			re, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				return fmt.Errorf("%s: invalid pattern: %w", path, err)
+			}
+			if !re.MatchString(v) {
+				return fmt.Errorf("%s: %q doesn't match %s", path, v, s.Pattern)
+			}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			return fmt.Errorf("%s: %v is less than %v", path, v, *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			return fmt.Errorf("%s: %v is greater than %v", path, v, *s.Maximum)
+		}
+	}
+
+	return s.validateCombinators(path, v)
+}
+
+func (s *schema) validateObject(path string, v map[string]interface{}) error {
+	for _, name := range s.Required {
+		if _, ok := v[name]; !ok {
+			return fmt.Errorf("%s: missing required property %q", path, name)
+		}
+	}
+
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s: unexpected property %q", path, name)
+			}
+			continue
+		}
+		if err := prop.validate(path+"."+name, v[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *schema) validateCombinators(path string, v interface{}) error {
+	for _, sub := range s.AllOf {
+		if err := sub.validate(path, v); err != nil {
+			return err
+		}
+	}
+
+	if len(s.AnyOf) > 0 {
+		matched := false
+		for _, sub := range s.AnyOf {
+			if sub.validate(path, v) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: doesn't match any schema of anyOf", path)
+		}
+	}
+
+	if len(s.OneOf) > 0 {
+		matches := 0
+		for _, sub := range s.OneOf {
+			if sub.validate(path, v) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%s: matches %d schemas of oneOf, want exactly 1", path, matches)
+		}
+	}
+
+	return nil
+}
+
+func (s *schema) validateType(path string, v interface{}) error {
+	var types []string
+	switch t := s.Type.(type) {
+	case nil:
+		return nil
+	case string:
+		types = []string{t}
+	case []interface{}:
+		for _, name := range t {
+			if name, ok := name.(string); ok {
+				types = append(types, name)
+			}
+		}
+	}
+
+	for _, t := range types {
+		if hasType(v, t) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: %s, want type %v", path, typeName(v), s.Type)
+}
+
+func hasType(v interface{}, t string) bool {
+	switch t {
+	case "integer":
+		f, ok := v.(float64)
+		return ok && f == math.Trunc(f)
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	default:
+		return typeName(v) == t
+	}
+}
+
+// typeName returns the json schema type of a decoded json value.
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, value := range values {
+		if reflect.DeepEqual(value, v) {
+			return true
+		}
+	}
+	return false
+}
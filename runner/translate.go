@@ -0,0 +1,475 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/actatum/postman-client/collections"
+)
+
+// Patterns of the script statements understood by Translate. str matches a quoted string literal.
+const str = `("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|` + "`[^`$]*`)"
+
+var (
+	testPattern      = regexp.MustCompile(`pm\.test\(\s*` + str + `\s*,\s*(?:function\s*\(\s*\)|\(\s*\)\s*=>)\s*\{`)
+	jsonAliasPattern = regexp.MustCompile(
+		`(?:var|let|const)\s+([\w$]+)\s*=\s*(?:pm\.response\.json\(\)|JSON\.parse\(responseBody\))`,
+	)
+	objectVarPattern = regexp.MustCompile(`(?:var|let|const)\s+([\w$]+)\s*=\s*\{`)
+	varPattern       = regexp.MustCompile(`^(?:var|let|const)\s+[\w$]+\s*=`)
+
+	statusPattern      = regexp.MustCompile(`^pm\.response\.to\.have\.status\((\d+)\)$`)
+	statusClassPattern = regexp.MustCompile(`^pm\.response\.to\.(?:be|not\.be)\.(\w+)$`)
+	statusCodePattern  = regexp.MustCompile(
+		`^pm\.expect\(pm\.response\.code\)\.to\.(?:be\.)?(?:deep\.)?(?:eql|equal|eq|equals)\((\d+)\)$`,
+	)
+	headerPattern    = regexp.MustCompile(`^pm\.response\.to\.have\.header\(\s*` + str + `\s*(?:,\s*` + str + `\s*)?\)$`)
+	headerGetPattern = regexp.MustCompile(
+		`^pm\.expect\(pm\.response\.headers\.get\(\s*` + str + `\s*\)\)\.to\.(?:be\.)?(\w+)\(\s*` + str + `\s*\)$`,
+	)
+	responseTimePattern = regexp.MustCompile(
+		`^pm\.expect\(pm\.response\.responseTime\)\.to\.be\.(below|lessThan|above|greaterThan)\((\d+(?:\.\d+)?)\)$`,
+	)
+	accessorsPattern = regexp.MustCompile(`^(?:\.[\w$]+|\[(?:-?\d+|'[^']*'|"[^"]*")\])*$`)
+	expectPattern    = regexp.MustCompile(`^pm\.expect\((.+?)\)\.to\.(.+)$`)
+	schemaPattern    = regexp.MustCompile(`^pm\.response\.to\.have\.jsonSchema\(\s*([\w$]+)\s*\)$`)
+	tv4Pattern       = regexp.MustCompile(`^pm\.expect\(tv4\.validate\(\s*[\w$.()]+\s*,\s*([\w$]+)\s*\)\)\.to\.be\.true$`)
+	equalsChain      = regexp.MustCompile(`^(?:be\.)?(?:deep\.)?(?:eql|equal|eq|equals)\((.+)\)$`)
+	includeChain     = regexp.MustCompile(`^(?:be\.)?(?:include|contain|includes|contains)\(\s*` + str + `\s*\)$`)
+	matchChain       = regexp.MustCompile(`^match\(\s*/(.+)/([a-z]*)\s*\)$`)
+	propertyChain    = regexp.MustCompile(`^have\.property\(\s*` + str + `\s*(?:,\s*(.+))?\)$`)
+	quotedKeyRegex   = regexp.MustCompile(`([{,]\s*)([A-Za-z_$][\w$]*)\s*:`)
+	trailingComma    = regexp.MustCompile(`,(\s*[}\]])`)
+)
+
+// statusClasses maps the chai status properties of pm.response to status ranges.
+var statusClasses = map[string][2]int{
+	"ok":           {200, 200},
+	"success":      {200, 299},
+	"info":         {100, 199},
+	"redirection":  {300, 399},
+	"clientError":  {400, 499},
+	"serverError":  {500, 599},
+	"error":        {400, 599},
+	"accepted":     {202, 202},
+	"badRequest":   {400, 400},
+	"unauthorized": {401, 401},
+	"forbidden":    {403, 403},
+	"notFound":     {404, 404},
+	"rateLimited":  {429, 429},
+}
+
+// TranslateEvents translates the enabled test scripts of the events. See Translate.
+func TranslateEvents(events []collections.Event) ([]Assertion, []string) {
+	var assertions []Assertion
+	var untranslated []string
+	for _, e := range events {
+		if e.Disabled || e.Listen != collections.EventListenTest {
+			continue
+		}
+		a, u := Translate(e.Script)
+		assertions = append(assertions, a...)
+		untranslated = append(untranslated, u...)
+	}
+	return assertions, untranslated
+}
+
+// Translate makes a best effort translation of the common assertions of a test script, such as
+// pm.response.to.have.status(200) or pm.expect(jsonData.id).to.eql(1), into declarative assertions
+// named after their pm.test. The statements which couldn't be translated are returned along with them.
+func Translate(script collections.Script) ([]Assertion, []string) {
+	src := strings.Join(script.Exec, "\n")
+	t := translator{
+		aliases: map[string]bool{},
+		objects: map[string]string{},
+	}
+
+	// Collect the aliases of the json body and the object literals, e.g. schemas, declared
+	// anywhere in the script.
+	for _, m := range jsonAliasPattern.FindAllStringSubmatch(src, -1) {
+		t.aliases[m[1]] = true
+	}
+	for _, m := range objectVarPattern.FindAllStringSubmatchIndex(src, -1) {
+		open := m[1] - 1
+		if end := matchBrace(src, open); end != -1 {
+			t.objects[src[m[2]:m[3]]] = src[open : end+1]
+		}
+	}
+
+	var outside strings.Builder
+	rest := src
+	for {
+		m := testPattern.FindStringSubmatchIndex(rest)
+		if m == nil {
+			outside.WriteString(rest)
+			break
+		}
+		open := m[1] - 1
+		end := matchBrace(rest, open)
+		if end == -1 {
+			outside.WriteString(rest)
+			break
+		}
+
+		outside.WriteString(rest[:m[0]])
+		name := unquote(rest[m[2]:m[3]])
+		t.translate(name, rest[open+1:end])
+
+		rest = rest[end+1:]
+		// Skip the closing parenthesis of pm.test.
+		rest = strings.TrimLeft(rest, " \t")
+		rest = strings.TrimPrefix(rest, ");")
+		rest = strings.TrimPrefix(rest, ")")
+	}
+	t.translate("", outside.String())
+
+	return t.assertions, t.untranslated
+}
+
+type translator struct {
+	aliases      map[string]bool
+	objects      map[string]string
+	assertions   []Assertion
+	untranslated []string
+}
+
+// translate translates the statements of a pm.test callback, or of the script outside of them
+// when name is empty.
+func (t *translator) translate(name, body string) {
+	for _, stmt := range statements(body) {
+		if m := jsonAliasPattern.FindString(stmt); m == stmt {
+			continue
+		}
+		if varPattern.MatchString(stmt) {
+			// Object literals were already collected.
+			if m := objectVarPattern.FindStringSubmatch(stmt); m != nil {
+				continue
+			}
+		}
+
+		a, ok := t.assertion(stmt)
+		if !ok {
+			t.untranslated = append(t.untranslated, stmt)
+			continue
+		}
+		if name != "" {
+			a.Name = name
+		}
+		t.assertions = append(t.assertions, a)
+	}
+}
+
+// assertion translates a single statement.
+func (t *translator) assertion(stmt string) (Assertion, bool) {
+	if m := statusPattern.FindStringSubmatch(stmt); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return StatusIs(code), true
+	}
+	if m := statusCodePattern.FindStringSubmatch(stmt); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return StatusIs(code), true
+	}
+	if m := statusClassPattern.FindStringSubmatch(stmt); m != nil && !strings.Contains(stmt, ".not.") {
+		if r, ok := statusClasses[m[1]]; ok {
+			if r[0] == r[1] {
+				return StatusIs(r[0]), true
+			}
+			return StatusBetween(r[0], r[1]), true
+		}
+	}
+	if m := headerPattern.FindStringSubmatch(stmt); m != nil {
+		if m[2] == "" {
+			return HeaderExists(unquote(m[1])), true
+		}
+		return HeaderEquals(unquote(m[1]), unquote(m[2])), true
+	}
+	if m := headerGetPattern.FindStringSubmatch(stmt); m != nil {
+		name, value := unquote(m[1]), unquote(m[3])
+		switch m[2] {
+		case "eql", "equal", "eq", "equals":
+			return HeaderEquals(name, value), true
+		case "include", "contain", "includes", "contains":
+			a := HeaderEquals(name, value)
+			a.Op = OpContains
+			return a, true
+		}
+		return Assertion{}, false
+	}
+	if m := responseTimePattern.FindStringSubmatch(stmt); m != nil {
+		ms, _ := strconv.ParseFloat(m[2], 64)
+		a := Assertion{Name: "response time", Kind: KindResponseTime}
+		if m[1] == "below" || m[1] == "lessThan" {
+			a.Max = float(ms)
+		} else {
+			a.Min = float(ms)
+		}
+		return a, true
+	}
+	if m := schemaPattern.FindStringSubmatch(stmt); m != nil {
+		return t.schemaAssertion(m[1])
+	}
+	if m := tv4Pattern.FindStringSubmatch(stmt); m != nil {
+		return t.schemaAssertion(m[1])
+	}
+	if m := expectPattern.FindStringSubmatch(stmt); m != nil {
+		path, ok := t.jsonPath(m[1])
+		if !ok {
+			return Assertion{}, false
+		}
+		return jsonAssertion(path, m[2])
+	}
+	return Assertion{}, false
+}
+
+func (t *translator) schemaAssertion(name string) (Assertion, bool) {
+	literal, ok := t.objects[name]
+	if !ok {
+		return Assertion{}, false
+	}
+	v, ok := parseLiteral(literal)
+	if !ok {
+		return Assertion{}, false
+	}
+	schema, err := json.Marshal(v)
+	if err != nil {
+		return Assertion{}, false
+	}
+	return MatchesSchema(schema), true
+}
+
+// jsonPath converts an expression reading the json body, e.g. jsonData.items[0].id or
+// pm.response.json().id, to a json path.
+func (t *translator) jsonPath(expr string) (string, bool) {
+	expr = strings.TrimSpace(expr)
+	for _, prefix := range []string{"pm.response.json()", "JSON.parse(responseBody)"} {
+		if strings.HasPrefix(expr, prefix) {
+			return "$" + expr[len(prefix):], validPath(expr[len(prefix):])
+		}
+	}
+	end := strings.IndexAny(expr, ".[")
+	if end == -1 {
+		end = len(expr)
+	}
+	if !t.aliases[expr[:end]] {
+		return "", false
+	}
+	return "$" + expr[end:], validPath(expr[end:])
+}
+
+func validPath(p string) bool {
+	return accessorsPattern.MatchString(p)
+}
+
+// jsonAssertion translates the chai chain of pm.expect for the value at path.
+func jsonAssertion(path, chain string) (Assertion, bool) {
+	switch chain {
+	case "exist", "not.be.undefined", "not.be.null":
+		return JSONPathExists(path), true
+	case "be.true":
+		return JSONPathEquals(path, true), true
+	case "be.false":
+		return JSONPathEquals(path, false), true
+	case "be.null":
+		a := JSONPathEquals(path, nil)
+		a.Name = path + " is null"
+		return a, true
+	}
+	if m := equalsChain.FindStringSubmatch(chain); m != nil {
+		if v, ok := parseLiteral(m[1]); ok {
+			return JSONPathEquals(path, v), true
+		}
+		return Assertion{}, false
+	}
+	if m := includeChain.FindStringSubmatch(chain); m != nil {
+		a := JSONPathEquals(path, unquote(m[1]))
+		a.Op = OpContains
+		a.Name = path + " contains " + unquote(m[1])
+		return a, true
+	}
+	if m := matchChain.FindStringSubmatch(chain); m != nil {
+		pattern := m[1]
+		if strings.Contains(m[2], "i") {
+			pattern = "(?i)" + pattern
+		}
+		return JSONPathMatches(path, pattern), true
+	}
+	if m := propertyChain.FindStringSubmatch(chain); m != nil {
+		key := unquote(m[1])
+		if path == "$" {
+			path = "$." + key
+		} else {
+			path += "." + key
+		}
+		if m[2] == "" {
+			return JSONPathExists(path), true
+		}
+		if v, ok := parseLiteral(m[2]); ok {
+			return JSONPathEquals(path, v), true
+		}
+	}
+	return Assertion{}, false
+}
+
+// statements splits js source into trimmed statements, dropping comments and closing braces.
+func statements(src string) []string {
+	var stmts []string
+	var b strings.Builder
+	depth := 0
+	var quote byte
+
+	flush := func() {
+		s := strings.TrimSpace(b.String())
+		b.Reset()
+		s = strings.TrimSpace(strings.TrimRight(s, ";"))
+		if s != "" && s != "}" && s != "});" && s != "})" {
+			stmts = append(stmts, s)
+		}
+	}
+
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case quote != 0:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(src) {
+				i++
+				b.WriteByte(src[i])
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+			b.WriteByte(c)
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			if depth == 0 {
+				flush()
+			}
+		case c == '{' || c == '(' || c == '[':
+			depth++
+			b.WriteByte(c)
+		case c == '}' || c == ')' || c == ']':
+			depth--
+			b.WriteByte(c)
+		case (c == ';' || c == '\n') && depth <= 0:
+			depth = 0
+			flush()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	flush()
+
+	// Collapse the whitespace of statements spanning several lines.
+	for i, s := range stmts {
+		stmts[i] = strings.Join(strings.Fields(s), " ")
+		stmts[i] = strings.ReplaceAll(stmts[i], "( ", "(")
+		stmts[i] = strings.ReplaceAll(stmts[i], " )", ")")
+		stmts[i] = strings.ReplaceAll(stmts[i], " .", ".")
+	}
+	return stmts
+}
+
+// matchBrace returns the index of the brace closing the one at open, skipping string literals.
+func matchBrace(src string, open int) int {
+	depth := 0
+	var quote byte
+	for i := open; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseLiteral parses a js literal: a string, number, boolean, null, array or object.
+func parseLiteral(s string) (interface{}, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, false
+	}
+	if s[0] == '\'' || s[0] == '`' {
+		return unquote(s), true
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err == nil {
+		return v, true
+	}
+
+	// Convert js object literals to json: quote the keys, use double quotes and drop trailing commas.
+	converted := convertQuotes(s)
+	converted = quotedKeyRegex.ReplaceAllString(converted, `$1"$2":`)
+	converted = trailingComma.ReplaceAllString(converted, "$1")
+	if err := json.Unmarshal([]byte(converted), &v); err == nil {
+		return v, true
+	}
+	return nil, false
+}
+
+// convertQuotes converts the single quoted strings of js source to double quoted ones.
+func convertQuotes(s string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == 0 && (c == '\'' || c == '"'):
+			quote = c
+			b.WriteByte('"')
+		case quote != 0 && c == '\\' && i+1 < len(s):
+			i++
+			if s[i] == '\'' {
+				b.WriteByte('\'')
+			} else {
+				b.WriteByte('\\')
+				b.WriteByte(s[i])
+			}
+		case quote != 0 && c == quote:
+			quote = 0
+			b.WriteByte('"')
+		case quote == '\'' && c == '"':
+			b.WriteString(`\"`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// unquote returns the content of a quoted string literal.
+func unquote(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+	if s[0] == '"' {
+		if v, err := strconv.Unquote(s); err == nil {
+			return v
+		}
+	}
+	if s[0] == '\'' || s[0] == '`' || s[0] == '"' {
+		inner := s[1 : len(s)-1]
+		return strings.NewReplacer(`\'`, `'`, `\"`, `"`, `\\`, `\`).Replace(inner)
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/actatum/postman-client/collections"
+)
+
+func TestTranslate(t *testing.T) {
+	script := collections.Script{Exec: []string{
+		`var jsonData = pm.response.json();`,
+		`var schema = {`,
+		`    type: 'object',`,
+		`    required: ['id'],`,
+		`};`,
+		`pm.test("Status code is 200", function () {`,
+		`    pm.response.to.have.status(200);`,
+		`});`,
+		`pm.test("Order is returned", () => {`,
+		`    pm.expect(jsonData.id).to.eql(1);`,
+		`    pm.expect(jsonData.items[0].name).to.equal('Tea');`,
+		`    pm.expect(jsonData.customer).to.have.property("email");`,
+		`    pm.expect(pm.response.json().status).to.match(/^pai/i);`,
+		`    pm.expect(jsonData.total > 0).to.be.true;`,
+		`});`,
+		`pm.test("Headers", function () {`,
+		`    pm.response.to.have.header("Content-Type", "application/json");`,
+		`    pm.expect(pm.response.headers.get('Cache-Control')).to.include('no-cache');`,
+		`    pm.expect(pm.response.responseTime).to.be.below(500);`,
+		`});`,
+		`pm.test("Schema is valid", function () {`,
+		`    pm.response.to.have.jsonSchema(schema);`,
+		`});`,
+		`console.log(jsonData);`,
+	}}
+
+	contains := HeaderEquals("Cache-Control", "no-cache")
+	contains.Name, contains.Op = "Headers", OpContains
+	responseTime := Assertion{Name: "Headers", Kind: KindResponseTime, Max: float(500)}
+	want := []Assertion{
+		named(StatusIs(200), "Status code is 200"),
+		named(JSONPathEquals("$.id", float64(1)), "Order is returned"),
+		named(JSONPathEquals("$.items[0].name", "Tea"), "Order is returned"),
+		named(JSONPathExists("$.customer.email"), "Order is returned"),
+		named(JSONPathMatches("$.status", "(?i)^pai"), "Order is returned"),
+		named(HeaderEquals("Content-Type", "application/json"), "Headers"),
+		contains,
+		responseTime,
+		named(MatchesSchema([]byte(`{"required":["id"],"type":"object"}`)), "Schema is valid"),
+	}
+	wantUntranslated := []string{"pm.expect(jsonData.total > 0).to.be.true", "console.log(jsonData)"}
+
+	got, untranslated := Translate(script)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Translate() got = %+v, want %+v", got, want)
+	}
+	if !reflect.DeepEqual(untranslated, wantUntranslated) {
+		t.Errorf("Translate() untranslated got = %q, want %q", untranslated, wantUntranslated)
+	}
+}
+
+func TestTranslateEvents(t *testing.T) {
+	events := []collections.Event{
+		{Listen: collections.EventListenPreRequest, Script: collections.Script{Exec: []string{`pm.response.to.be.ok;`}}},
+		{Listen: collections.EventListenTest, Script: collections.Script{Exec: []string{`pm.response.to.be.success;`}}},
+		{Listen: collections.EventListenTest, Disabled: true, Script: collections.Script{Exec: []string{`x();`}}},
+	}
+
+	got, untranslated := TranslateEvents(events)
+	if want := []Assertion{StatusBetween(200, 299)}; !reflect.DeepEqual(got, want) || len(untranslated) != 0 {
+		t.Errorf("TranslateEvents() got = %+v, %q, want %+v", got, untranslated, want)
+	}
+}
+
+func named(a Assertion, name string) Assertion {
+	a.Name = name
+	return a
+}
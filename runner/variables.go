@@ -0,0 +1,30 @@
+// Package runner provides a local runner executing the requests of a collection over http.
+package runner
+
+import "github.com/actatum/postman-client/variables"
+
+// resolver resolves the variables of an execution, recording the references which couldn't be resolved.
+type resolver struct {
+	vars       *variables.Resolver
+	unresolved []string
+}
+
+// resolve replaces the references in v, leaving unknown ones as is.
+func (r *resolver) resolve(v string) string {
+	resolved, unresolved := r.vars.Resolve(v)
+	for _, name := range unresolved {
+		if !contains(r.unresolved, name) {
+			r.unresolved = append(r.unresolved, name)
+		}
+	}
+	return resolved
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,366 @@
+// Package scim provides types/client for making requests to /scim/v2.
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+const path = "/scim/v2"
+
+// Client handles scim operations. Requests must be authenticated with a scim api key.
+type Client struct {
+	restClient *rest.Client
+}
+
+// NewClient returns a new instance of Client.
+func NewClient(restClient *rest.Client) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// GetResourceTypes sends a GET request to /scim/v2/ResourceTypes.
+func (c *Client) GetResourceTypes(
+	ctx context.Context,
+	opts ...rest.RequestOption,
+) (ListResponse[ResourceType], error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/ResourceTypes", c.restClient.BaseURL(), path),
+		nil,
+	)
+	if err != nil {
+		return ListResponse[ResourceType]{}, err
+	}
+
+	var response ListResponse[ResourceType]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetServiceProviderConfig sends a GET request to /scim/v2/ServiceProviderConfig.
+func (c *Client) GetServiceProviderConfig(
+	ctx context.Context,
+	opts ...rest.RequestOption,
+) (ServiceProviderConfig, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/ServiceProviderConfig", c.restClient.BaseURL(), path),
+		nil,
+	)
+	if err != nil {
+		return ServiceProviderConfig{}, err
+	}
+
+	var response ServiceProviderConfig
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// CreateUser sends a POST request to /scim/v2/Users.
+func (c *Client) CreateUser(
+	ctx context.Context,
+	user User,
+	opts ...rest.RequestOption,
+) (User, error) {
+	if len(user.Schemas) == 0 {
+		user.Schemas = []string{SchemaUser}
+	}
+
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/Users", c.restClient.BaseURL(), path),
+		user,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	var response User
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetUser sends a GET request to /scim/v2/Users/:id.
+func (c *Client) GetUser(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (User, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/Users/%s", c.restClient.BaseURL(), path, url.PathEscape(id)),
+		nil,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	var response User
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetUsers sends a GET request to /scim/v2/Users, returning a single page of users.
+func (c *Client) GetUsers(
+	ctx context.Context,
+	req ListRequest,
+	opts ...rest.RequestOption,
+) (ListResponse[User], error) {
+	return list[User](ctx, c, "/Users", req, opts)
+}
+
+// GetAllUsers sends GET requests to /scim/v2/Users, paging through the results until every user
+// matching req is returned.
+func (c *Client) GetAllUsers(
+	ctx context.Context,
+	req ListRequest,
+	opts ...rest.RequestOption,
+) ([]User, error) {
+	return listAll[User](ctx, c, "/Users", req, opts)
+}
+
+// UpdateUser sends a PUT request to /scim/v2/Users/:id, replacing the user.
+func (c *Client) UpdateUser(
+	ctx context.Context,
+	id string,
+	user User,
+	opts ...rest.RequestOption,
+) (User, error) {
+	if len(user.Schemas) == 0 {
+		user.Schemas = []string{SchemaUser}
+	}
+
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/Users/%s", c.restClient.BaseURL(), path, url.PathEscape(id)),
+		user,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	var response User
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// PatchUser sends a PATCH request to /scim/v2/Users/:id applying the given operations.
+func (c *Client) PatchUser(
+	ctx context.Context,
+	id string,
+	operations []Operation,
+	opts ...rest.RequestOption,
+) (User, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("%s%s/Users/%s", c.restClient.BaseURL(), path, url.PathEscape(id)),
+		newPatchRequest(operations),
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	var response User
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// CreateGroup sends a POST request to /scim/v2/Groups.
+func (c *Client) CreateGroup(
+	ctx context.Context,
+	group Group,
+	opts ...rest.RequestOption,
+) (Group, error) {
+	if len(group.Schemas) == 0 {
+		group.Schemas = []string{SchemaGroup}
+	}
+
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s%s/Groups", c.restClient.BaseURL(), path),
+		group,
+	)
+	if err != nil {
+		return Group{}, err
+	}
+
+	var response Group
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetGroup sends a GET request to /scim/v2/Groups/:id.
+func (c *Client) GetGroup(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Group, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/Groups/%s", c.restClient.BaseURL(), path, url.PathEscape(id)),
+		nil,
+	)
+	if err != nil {
+		return Group{}, err
+	}
+
+	var response Group
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// GetGroups sends a GET request to /scim/v2/Groups, returning a single page of groups.
+func (c *Client) GetGroups(
+	ctx context.Context,
+	req ListRequest,
+	opts ...rest.RequestOption,
+) (ListResponse[Group], error) {
+	return list[Group](ctx, c, "/Groups", req, opts)
+}
+
+// GetAllGroups sends GET requests to /scim/v2/Groups, paging through the results until every group
+// matching req is returned.
+func (c *Client) GetAllGroups(
+	ctx context.Context,
+	req ListRequest,
+	opts ...rest.RequestOption,
+) ([]Group, error) {
+	return listAll[Group](ctx, c, "/Groups", req, opts)
+}
+
+// PatchGroup sends a PATCH request to /scim/v2/Groups/:id applying the given operations.
+func (c *Client) PatchGroup(
+	ctx context.Context,
+	id string,
+	operations []Operation,
+	opts ...rest.RequestOption,
+) (Group, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("%s%s/Groups/%s", c.restClient.BaseURL(), path, url.PathEscape(id)),
+		newPatchRequest(operations),
+	)
+	if err != nil {
+		return Group{}, err
+	}
+
+	var response Group
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// DeleteGroup sends a DELETE request to /scim/v2/Groups/:id.
+func (c *Client) DeleteGroup(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) error {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/Groups/%s", c.restClient.BaseURL(), path, url.PathEscape(id)),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.restClient.DoRequest(r, nil, opts...)
+}
+
+func newPatchRequest(operations []Operation) PatchRequest {
+	return PatchRequest{
+		Schemas:    []string{SchemaPatchOp},
+		Operations: operations,
+	}
+}
+
+// list sends a GET request for a page of the given resource.
+func list[T any](
+	ctx context.Context,
+	c *Client,
+	resource string,
+	req ListRequest,
+	opts []rest.RequestOption,
+) (ListResponse[T], error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s%s", c.restClient.BaseURL(), path, resource),
+		nil,
+	)
+	if err != nil {
+		return ListResponse[T]{}, err
+	}
+	q := r.URL.Query()
+	if req.Filter != "" {
+		q.Add("filter", string(req.Filter))
+	}
+	if req.StartIndex != nil {
+		q.Add("startIndex", strconv.Itoa(*req.StartIndex))
+	}
+	if req.Count != nil {
+		q.Add("count", strconv.Itoa(*req.Count))
+	}
+	r.URL.RawQuery = q.Encode()
+
+	var response ListResponse[T]
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response, err
+}
+
+// listAll pages through the given resource starting at req.StartIndex until totalResults are returned.
+func listAll[T any](
+	ctx context.Context,
+	c *Client,
+	resource string,
+	req ListRequest,
+	opts []rest.RequestOption,
+) ([]T, error) {
+	start := 1
+	if req.StartIndex != nil {
+		start = *req.StartIndex
+	}
+
+	var resources []T
+	for {
+		index := start
+		req.StartIndex = &index
+		page, err := list[T](ctx, c, resource, req, opts)
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, page.Resources...)
+
+		start += len(page.Resources)
+		if len(page.Resources) == 0 || start > page.TotalResults {
+			return resources, nil
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Package scim provides types/client for making requests to /scim/v2.
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/actatum/postman-client/rest"
+)
+
+func TestClient_GetAllUsers(t *testing.T) {
+	users := make([]User, 5)
+	for i := range users {
+		users[i] = User{ID: strconv.Itoa(i + 1), UserName: fmt.Sprintf("user%d@example.com", i+1)}
+	}
+
+	var filters []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/scim/v2/Users" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		q := r.URL.Query()
+		filters = append(filters, q.Get("filter"))
+		start, _ := strconv.Atoi(q.Get("startIndex"))
+		count, _ := strconv.Atoi(q.Get("count"))
+		end := start - 1 + count
+		if end > len(users) {
+			end = len(users)
+		}
+		_ = json.NewEncoder(w).Encode(ListResponse[User]{
+			Schemas:      []string{SchemaListResponse},
+			TotalResults: len(users),
+			StartIndex:   start,
+			ItemsPerPage: end - start + 1,
+			Resources:    users[start-1 : end],
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	count := 2
+	got, err := c.GetAllUsers(context.Background(), ListRequest{Filter: Sw("userName", "user"), Count: &count})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(users) || got[4].ID != "5" {
+		t.Errorf("GetAllUsers() got = %+v, want %+v", got, users)
+	}
+	if len(filters) != 3 || filters[0] != `userName sw "user"` {
+		t.Errorf("filters got = %v, want 3 requests filtering by userName", filters)
+	}
+}
+
+func TestClient_PatchGroup(t *testing.T) {
+	var got PatchRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/scim/v2/Groups/group-1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],` +
+				`"detail":"Group not found","status":"404"}`))
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_ = json.NewEncoder(w).Encode(Group{ID: "group-1", DisplayName: "Core"})
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(rest.NewClient("key", rest.WithBaseURL(srv.URL)))
+	ctx := context.Background()
+
+	group, err := c.PatchGroup(ctx, "group-1", []Operation{AddMembers("1"), Replace("displayName", "Core")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group.DisplayName != "Core" {
+		t.Errorf("PatchGroup() got = %+v, want Core group", group)
+	}
+	if len(got.Schemas) != 1 || got.Schemas[0] != SchemaPatchOp || len(got.Operations) != 2 {
+		t.Errorf("PatchGroup() request got = %+v, want patch op with 2 operations", got)
+	}
+
+	_, err = c.GetGroup(ctx, "missing")
+	var e *rest.Error
+	if !errors.As(err, &e) || !errors.Is(err, rest.ErrNotFound) || e.Message != "Group not found" {
+		t.Errorf("GetGroup() error got = %v, want scim not found error", err)
+	}
+}
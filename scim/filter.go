@@ -0,0 +1,143 @@
+// Package scim provides types/client for making requests to /scim/v2.
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Filter is a scim filter expression (RFC 7644 section 3.4.2.2), e.g. `userName eq "taylor@example.com"`.
+type Filter string
+
+// Eq returns a filter matching resources whose attribute equals value.
+func Eq(attribute string, value interface{}) Filter {
+	return compare(attribute, "eq", value)
+}
+
+// Ne returns a filter matching resources whose attribute does not equal value.
+func Ne(attribute string, value interface{}) Filter {
+	return compare(attribute, "ne", value)
+}
+
+// Co returns a filter matching resources whose attribute contains value.
+func Co(attribute string, value string) Filter {
+	return compare(attribute, "co", value)
+}
+
+// Sw returns a filter matching resources whose attribute starts with value.
+func Sw(attribute string, value string) Filter {
+	return compare(attribute, "sw", value)
+}
+
+// Ew returns a filter matching resources whose attribute ends with value.
+func Ew(attribute string, value string) Filter {
+	return compare(attribute, "ew", value)
+}
+
+// Pr returns a filter matching resources which have a value for attribute.
+func Pr(attribute string) Filter {
+	return Filter(attribute + " pr")
+}
+
+// And returns a filter matching resources which match every filter.
+func And(filters ...Filter) Filter {
+	return join("and", filters)
+}
+
+// Or returns a filter matching resources which match any of the filters.
+func Or(filters ...Filter) Filter {
+	return join("or", filters)
+}
+
+// Not returns a filter matching resources which do not match f.
+func Not(f Filter) Filter {
+	return Filter(fmt.Sprintf("not (%s)", f))
+}
+
+func compare(attribute, operator string, value interface{}) Filter {
+	return Filter(fmt.Sprintf("%s %s %s", attribute, operator, literal(value)))
+}
+
+func join(operator string, filters []Filter) Filter {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f != "" {
+			parts = append(parts, string(f))
+		}
+	}
+	if len(parts) > 1 {
+		for i, p := range parts {
+			parts[i] = "(" + p + ")"
+		}
+	}
+	return Filter(strings.Join(parts, " "+operator+" "))
+}
+
+// literal formats value as a filter literal. Numbers and booleans are written as is, other values
+// are written as json strings.
+func literal(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case json.Number:
+		return v.String()
+	case fmt.Stringer:
+		return quote(v.String())
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	default:
+		return quote(fmt.Sprint(value))
+	}
+}
+
+// quote encodes s as a json string.
+func quote(s string) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	// Encoding a string never fails.
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Add returns an operation adding value at path. An empty path adds the attributes of value to the resource.
+func Add(path string, value interface{}) Operation {
+	return Operation{Op: OpAdd, Path: path, Value: value}
+}
+
+// Replace returns an operation replacing the value at path, e.g. Replace("active", false) to deactivate a user.
+func Replace(path string, value interface{}) Operation {
+	return Operation{Op: OpReplace, Path: path, Value: value}
+}
+
+// Remove returns an operation removing the value at path.
+func Remove(path string) Operation {
+	return Operation{Op: OpRemove, Path: path}
+}
+
+// AddMembers returns an operation adding the users with the given ids to a group.
+func AddMembers(userIDs ...string) Operation {
+	members := make([]Member, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, Member{Value: id})
+	}
+	return Add("members", members)
+}
+
+// RemoveMember returns an operation removing the user with the given id from a group.
+func RemoveMember(userID string) Operation {
+	return Remove(fmt.Sprintf("members[%s]", Eq("value", userID)))
+}
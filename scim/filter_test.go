@@ -0,0 +1,118 @@
+// Package scim provides types/client for making requests to /scim/v2.
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		want   Filter
+	}{
+		{
+			name:   "eq string",
+			filter: Eq("userName", "taylor@example.com"),
+			want:   `userName eq "taylor@example.com"`,
+		},
+		{
+			name:   "quotes are escaped",
+			filter: Eq("displayName", `The "Core" Team`),
+			want:   `displayName eq "The \"Core\" Team"`,
+		},
+		{
+			name:   "eq bool",
+			filter: Eq("active", true),
+			want:   `active eq true`,
+		},
+		{
+			name:   "eq int64",
+			filter: Eq("meta.version", int64(3)),
+			want:   `meta.version eq 3`,
+		},
+		{
+			name:   "eq float64",
+			filter: Eq("score", 1.5),
+			want:   `score eq 1.5`,
+		},
+		{
+			name:   "eq json number",
+			filter: Eq("score", json.Number("42")),
+			want:   `score eq 42`,
+		},
+		{
+			name:   "control characters are json escaped",
+			filter: Eq("title", "a\tb\x01<é>"),
+			want:   `title eq "a\tb\u0001<é>"`,
+		},
+		{
+			name:   "present",
+			filter: Pr("externalId"),
+			want:   `externalId pr`,
+		},
+		{
+			name:   "and",
+			filter: And(Sw("userName", "t"), Ne("active", false)),
+			want:   `(userName sw "t") and (active ne false)`,
+		},
+		{
+			name:   "and with an empty filter",
+			filter: And(Pr("externalId"), Filter("")),
+			want:   `externalId pr`,
+		},
+		{
+			name:   "or with a single filter",
+			filter: Or(Ew("userName", "@example.com")),
+			want:   `userName ew "@example.com"`,
+		},
+		{
+			name:   "not",
+			filter: Not(Co("name.familyName", "son")),
+			want:   `not (name.familyName co "son")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.filter != tt.want {
+				t.Errorf("filter got = %v, want %v", tt.filter, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperations(t *testing.T) {
+	tests := []struct {
+		name string
+		op   Operation
+		want string
+	}{
+		{
+			name: "replace",
+			op:   Replace("active", false),
+			want: `{"op":"replace","path":"active","value":false}`,
+		},
+		{
+			name: "add members",
+			op:   AddMembers("1", "2"),
+			want: `{"op":"add","path":"members","value":[{"value":"1"},{"value":"2"}]}`,
+		},
+		{
+			name: "remove member",
+			op:   RemoveMember("1"),
+			want: `{"op":"remove","path":"members[value eq \"1\"]"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.op)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
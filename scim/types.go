@@ -0,0 +1,157 @@
+// Package scim provides types/client for making requests to /scim/v2.
+package scim
+
+import "time"
+
+// Schema urns of scim resources and messages.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// Possible values for patch operations.
+const (
+	OpAdd     = "add"
+	OpReplace = "replace"
+	OpRemove  = "remove"
+)
+
+// ListRequest is the request type for GET /scim/v2/Users and GET /scim/v2/Groups.
+type ListRequest struct {
+	// Return only resources matching the filter expression, e.g. Eq("userName", "taylor@example.com").
+	Filter Filter
+	// The 1-based index of the first result to return.
+	StartIndex *int
+	// The maximum number of results to return.
+	Count *int
+}
+
+// ListResponse is a page of scim resources.
+type ListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// User ...
+type User struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id,omitempty"` // Output only.
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName,omitempty"`
+	Name        Name     `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"` // Output only.
+}
+
+// Name ...
+type Name struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	Formatted  string `json:"formatted,omitempty"`
+}
+
+// Email ...
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Group ...
+type Group struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id,omitempty"` // Output only.
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"` // Output only.
+}
+
+// Member is a member of a group, Value being the id of the user.
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// Meta ...
+type Meta struct {
+	ResourceType string    `json:"resourceType,omitempty"`
+	Created      time.Time `json:"created,omitempty"`
+	LastModified time.Time `json:"lastModified,omitempty"`
+	Location     string    `json:"location,omitempty"`
+}
+
+// PatchRequest is the request type for PATCH /scim/v2/Users/:id and PATCH /scim/v2/Groups/:id.
+type PatchRequest struct {
+	Schemas    []string    `json:"schemas"`
+	Operations []Operation `json:"Operations"`
+}
+
+// Operation is a patch operation, built with Add, Replace and Remove.
+type Operation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ResourceType ...
+type ResourceType struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Endpoint    string   `json:"endpoint"`
+	Description string   `json:"description,omitempty"`
+	Schema      string   `json:"schema"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// ServiceProviderConfig ...
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas,omitempty"`
+	DocumentationURI      string                 `json:"documentationUri,omitempty"`
+	Patch                 Supported              `json:"patch"`
+	Bulk                  Bulk                   `json:"bulk"`
+	Filter                FilterSupport          `json:"filter"`
+	ChangePassword        Supported              `json:"changePassword"`
+	Sort                  Supported              `json:"sort"`
+	ETag                  Supported              `json:"etag"`
+	AuthenticationSchemes []AuthenticationScheme `json:"authenticationSchemes,omitempty"`
+	Meta                  *Meta                  `json:"meta,omitempty"`
+}
+
+// Supported ...
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// Bulk ...
+type Bulk struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations,omitempty"`
+	MaxPayloadSize int  `json:"maxPayloadSize,omitempty"`
+}
+
+// FilterSupport ...
+type FilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults,omitempty"`
+}
+
+// AuthenticationScheme ...
+type AuthenticationScheme struct {
+	Type             string `json:"type"`
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	SpecURI          string `json:"specUri,omitempty"`
+	DocumentationURI string `json:"documentationUri,omitempty"`
+	Primary          bool   `json:"primary,omitempty"`
+}
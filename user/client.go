@@ -24,7 +24,10 @@ func NewClient(restClient *rest.Client) *Client {
 }
 
 // GetAuthenticatedUser sends a GET request to /me.
-func (c *Client) GetAuthenticatedUser(ctx context.Context) (User, []Operation, error) {
+func (c *Client) GetAuthenticatedUser(
+	ctx context.Context,
+	opts ...rest.RequestOption,
+) (User, []Operation, error) {
 	r, err := c.restClient.NewRequest(
 		ctx,
 		http.MethodGet,
@@ -36,7 +39,7 @@ func (c *Client) GetAuthenticatedUser(ctx context.Context) (User, []Operation, e
 	}
 
 	var response authenticatedUserWrapper
-	err = c.restClient.DoRequest(r, &response)
+	err = c.restClient.DoRequest(r, &response, opts...)
 
 	return response.User, response.Operations, err
 }
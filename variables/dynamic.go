@@ -0,0 +1,80 @@
+// Package variables resolves {{variable}} references with the scope precedence of Postman.
+package variables
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	alphaNumeric = "abcdefghijklmnopqrstuvwxyz0123456789"
+	hexadecimal  = "0123456789abcdef"
+)
+
+var firstNames = []string{"Ada", "Alan", "Barbara", "Dennis", "Edsger", "Grace", "Ken", "Linus", "Margaret", "Rob"}
+
+var lastNames = []string{
+	"Hopper", "Kernighan", "Knuth", "Lamport", "Liskov", "Lovelace", "Pike", "Ritchie", "Thompson", "Turing",
+}
+
+// dynamic holds the generators of the dynamic variables, which take a new value on each use.
+var dynamic = map[string]func(r *Resolver) string{
+	"$guid":       (*Resolver).uuid,
+	"$randomUUID": (*Resolver).uuid,
+	"$timestamp": func(r *Resolver) string {
+		return strconv.FormatInt(r.now().Unix(), 10)
+	},
+	"$isoTimestamp": func(r *Resolver) string {
+		return r.now().UTC().Format("2006-01-02T15:04:05.000Z")
+	},
+	"$randomInt": func(r *Resolver) string {
+		return strconv.Itoa(r.rand.Intn(1001))
+	},
+	"$randomBoolean": func(r *Resolver) string {
+		return strconv.FormatBool(r.rand.Intn(2) == 1)
+	},
+	"$randomAlphaNumeric": func(r *Resolver) string {
+		return r.randomString(alphaNumeric, 1)
+	},
+	"$randomHexadecimal": func(r *Resolver) string {
+		return r.randomString(hexadecimal, 1)
+	},
+	"$randomPassword": func(r *Resolver) string {
+		return r.randomString(alphaNumeric, 15)
+	},
+	"$randomIP": func(r *Resolver) string {
+		return fmt.Sprintf("%d.%d.%d.%d", r.rand.Intn(256), r.rand.Intn(256), r.rand.Intn(256), r.rand.Intn(256))
+	},
+	"$randomFirstName": func(r *Resolver) string {
+		return firstNames[r.rand.Intn(len(firstNames))]
+	},
+	"$randomLastName": func(r *Resolver) string {
+		return lastNames[r.rand.Intn(len(lastNames))]
+	},
+	"$randomUserName": func(r *Resolver) string {
+		return strings.ToLower(firstNames[r.rand.Intn(len(firstNames))]) + "." +
+			strings.ToLower(lastNames[r.rand.Intn(len(lastNames))])
+	},
+	"$randomEmail": func(r *Resolver) string {
+		return strings.ToLower(firstNames[r.rand.Intn(len(firstNames))]) + "." +
+			strings.ToLower(lastNames[r.rand.Intn(len(lastNames))]) + "@example.com"
+	},
+}
+
+// uuid returns a random version 4 uuid.
+func (r *Resolver) uuid() string {
+	var b [16]byte
+	_, _ = r.rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func (r *Resolver) randomString(chars string, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = chars[r.rand.Intn(len(chars))]
+	}
+	return string(b)
+}
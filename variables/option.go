@@ -0,0 +1,47 @@
+// Package variables resolves {{variable}} references with the scope precedence of Postman.
+package variables
+
+import (
+	"math/rand"
+	"time"
+)
+
+type options struct {
+	now  func() time.Time
+	rand *rand.Rand
+}
+
+// Option represents functional options for configuring the Resolver.
+type Option interface {
+	apply(*options)
+}
+
+type clockOption func() time.Time
+
+func (c clockOption) apply(opts *options) {
+	if c != nil {
+		opts.now = c
+	}
+}
+
+// WithClock configures the clock of the time based dynamic variables, e.g. {{$timestamp}}.
+// Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return clockOption(now)
+}
+
+type randOption struct {
+	r *rand.Rand
+}
+
+func (r randOption) apply(opts *options) {
+	if r.r != nil {
+		opts.rand = r.r
+	}
+}
+
+// WithRand configures the source of the random dynamic variables, e.g. to make them deterministic
+// in tests. Defaults to a source seeded with the current time.
+func WithRand(r *rand.Rand) Option {
+	return randOption{r: r}
+}
@@ -0,0 +1,192 @@
+// Package variables resolves {{variable}} references with the scope precedence of Postman.
+//
+// A variable defined in several scopes takes the value of the most specific one, in the order
+// global < collection < environment < data < local. Values can reference other variables, and
+// the dynamic variables of Postman, such as {{$guid}} or {{$timestamp}}, are generated on each use.
+//
+//	r := variables.New()
+//	r.SetCollection(collection.Variables)
+//	r.SetEnvironment(env.Values)
+//	url, unresolved := r.Resolve("{{baseUrl}}/users/{{id}}")
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/environments"
+)
+
+// Scope is the scope a variable is defined in.
+type Scope int
+
+// Possible values for scopes, from the least to the most specific.
+const (
+	Global Scope = iota
+	Collection
+	Environment
+	Data
+	Local
+)
+
+var scopeNames = [...]string{"global", "collection", "environment", "data", "local"}
+
+// String returns the name of the scope.
+func (s Scope) String() string {
+	if s < Global || s > Local {
+		return fmt.Sprintf("Scope(%d)", int(s))
+	}
+	return scopeNames[s]
+}
+
+// maxDepth bounds the number of passes resolving nested references, e.g. of variables
+// referencing each other.
+const maxDepth = 16
+
+// pattern matches {{name}} references, innermost first.
+var pattern = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
+// Resolver resolves {{variable}} references. A Resolver is not safe for concurrent use.
+type Resolver struct {
+	scopes [Local + 1]map[string]string
+	now    func() time.Time
+	rand   *rand.Rand
+}
+
+// New returns a new instance of Resolver, without any variable.
+func New(opts ...Option) *Resolver {
+	options := options{
+		now: time.Now,
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	if options.rand == nil {
+		options.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	r := &Resolver{
+		now:  options.now,
+		rand: options.rand,
+	}
+	for i := range r.scopes {
+		r.scopes[i] = map[string]string{}
+	}
+	return r
+}
+
+// Set sets the variable in the scope.
+func (r *Resolver) Set(scope Scope, name, value string) {
+	r.scopes[scope][name] = value
+}
+
+// SetAll sets the variables in the scope, keeping the other variables of the scope.
+func (r *Resolver) SetAll(scope Scope, values map[string]string) {
+	for name, value := range values {
+		r.scopes[scope][name] = value
+	}
+}
+
+// SetCollection sets the collection variables. Disabled variables are ignored.
+func (r *Resolver) SetCollection(vars []collections.Variable) {
+	for _, v := range vars {
+		if v.Disabled {
+			continue
+		}
+		r.scopes[Collection][v.Key] = String(v.Value)
+	}
+}
+
+// SetEnvironment sets the environment variables. Values which aren't enabled are ignored.
+func (r *Resolver) SetEnvironment(values []environments.EnvironmentValue) {
+	for _, v := range values {
+		if v.Enabled {
+			r.scopes[Environment][v.Key] = v.Value
+		}
+	}
+}
+
+// Unset removes the variable from the scope.
+func (r *Resolver) Unset(scope Scope, name string) {
+	delete(r.scopes[scope], name)
+}
+
+// Clear removes the variables of the scope, e.g. the local variables after a request.
+func (r *Resolver) Clear(scope Scope) {
+	r.scopes[scope] = map[string]string{}
+}
+
+// Lookup returns the unresolved value of the variable and the scope it was found in.
+func (r *Resolver) Lookup(name string) (string, Scope, bool) {
+	for scope := Local; scope >= Global; scope-- {
+		if v, ok := r.scopes[scope][name]; ok {
+			return v, scope, true
+		}
+	}
+	return "", 0, false
+}
+
+// Resolve replaces the references in s, including the ones nested in values or in the names of
+// other references, e.g. {{user_{{id}}}}. References which can't be resolved, including cyclic ones,
+// are left as is and their names are returned in order of appearance.
+func (r *Resolver) Resolve(s string) (string, []string) {
+	for depth := 0; depth < maxDepth; depth++ {
+		replaced := false
+		s = pattern.ReplaceAllStringFunc(s, func(ref string) string {
+			if v, ok := r.value(ref[2 : len(ref)-2]); ok {
+				replaced = true
+				return v
+			}
+			return ref
+		})
+		if !replaced {
+			break
+		}
+	}
+
+	var unresolved []string
+	seen := map[string]bool{}
+	for _, m := range pattern.FindAllStringSubmatch(s, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			unresolved = append(unresolved, m[1])
+		}
+	}
+	return s, unresolved
+}
+
+// value returns the value of the variable, or of the dynamic variable, named name.
+func (r *Resolver) value(name string) (string, bool) {
+	if v, _, ok := r.Lookup(name); ok {
+		return v, true
+	}
+	if gen, ok := dynamic[name]; ok {
+		return gen(r), true
+	}
+	return "", false
+}
+
+// String returns the string form of a variable value, e.g. the number or boolean value of a
+// collection variable. Nil values are empty.
+func String(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		// Numbers decoded from json are float64, which fmt prints in exponent form when large.
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
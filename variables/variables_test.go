@@ -0,0 +1,144 @@
+// Package variables resolves {{variable}} references with the scope precedence of Postman.
+package variables
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/environments"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	r := New()
+	r.SetAll(Global, map[string]string{"baseUrl": "http://global", "version": "v1", "host": "api.example.com"})
+	r.SetCollection([]collections.Variable{
+		{Key: "baseUrl", Value: "https://{{host}}/{{version}}"},
+		{Key: "version", Value: "v2", Disabled: true},
+		{Key: "limit", Value: float64(10)},
+	})
+	r.SetEnvironment([]environments.EnvironmentValue{
+		{Key: "host", Value: "staging.example.com", Enabled: true},
+		{Key: "token", Value: "secret"},
+	})
+	r.SetAll(Data, map[string]string{"id": "1", "user_1": "ada"})
+	r.Set(Local, "a", "{{b}}")
+	r.Set(Local, "b", "{{a}}")
+
+	tests := []struct {
+		name           string
+		in             string
+		want           string
+		wantUnresolved []string
+	}{
+		{name: "no references", in: "plain", want: "plain"},
+		{name: "nested", in: "{{baseUrl}}/users/{{id}}", want: "https://staging.example.com/v1/users/1"},
+		{name: "non string value", in: "limit={{limit}}", want: "limit=10"},
+		{name: "nested name", in: "{{user_{{id}}}}", want: "ada"},
+		{
+			name:           "disabled",
+			in:             "Bearer {{token}}",
+			want:           "Bearer {{token}}",
+			wantUnresolved: []string{"token"},
+		},
+		{
+			name:           "unresolved in order",
+			in:             "{{missing}}/{{other}}/{{missing}}",
+			want:           "{{missing}}/{{other}}/{{missing}}",
+			wantUnresolved: []string{"missing", "other"},
+		},
+		{name: "cycle", in: "{{a}}", want: "{{a}}", wantUnresolved: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unresolved := r.Resolve(tt.in)
+			if got != tt.want {
+				t.Errorf("Resolve() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(unresolved, tt.wantUnresolved) {
+				t.Errorf("Resolve() unresolved got = %v, want %v", unresolved, tt.wantUnresolved)
+			}
+		})
+	}
+}
+
+func TestResolver_ResolveNumbers(t *testing.T) {
+	var vars []collections.Variable
+	err := json.Unmarshal([]byte(`[{"key":"id","value":12345678},{"key":"big","value":1e23},{"key":"ratio","value":0.25}]`), &vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := New()
+	r.SetCollection(vars)
+
+	got, _ := r.Resolve("/users/{{id}}?big={{big}}&ratio={{ratio}}")
+	if want := "/users/12345678?big=100000000000000000000000&ratio=0.25"; got != want {
+		t.Errorf("Resolve() got = %v, want %v", got, want)
+	}
+}
+
+func TestResolver_Lookup(t *testing.T) {
+	r := New()
+	for scope := Global; scope <= Local; scope++ {
+		r.Set(scope, "name", scope.String())
+
+		got, gotScope, ok := r.Lookup("name")
+		if !ok || got != scope.String() || gotScope != scope {
+			t.Errorf("Lookup() got = %v, %v, %v, want %v", got, gotScope, ok, scope)
+		}
+	}
+
+	r.Unset(Local, "name")
+	if got, _, _ := r.Lookup("name"); got != "data" {
+		t.Errorf("Lookup() got = %v, want data", got)
+	}
+	r.Clear(Data)
+	if got, _, _ := r.Lookup("name"); got != "environment" {
+		t.Errorf("Lookup() got = %v, want environment", got)
+	}
+	if _, _, ok := r.Lookup("missing"); ok {
+		t.Error("Lookup() ok got = true, want false")
+	}
+}
+
+func TestResolver_ResolveDynamic(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := New(WithClock(func() time.Time { return now }), WithRand(rand.New(rand.NewSource(1))))
+
+	tests := []struct {
+		in      string
+		pattern string
+	}{
+		{in: "{{$guid}}", pattern: `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`},
+		{in: "{{$timestamp}}", pattern: `^1714557600$`},
+		{in: "{{$isoTimestamp}}", pattern: `^2024-05-01T10:00:00\.000Z$`},
+		{in: "{{$randomInt}}", pattern: `^\d{1,4}$`},
+		{in: "{{$randomBoolean}}", pattern: `^(true|false)$`},
+		{in: "{{$randomAlphaNumeric}}", pattern: `^[a-z0-9]$`},
+		{in: "{{$randomIP}}", pattern: `^\d+\.\d+\.\d+\.\d+$`},
+		{in: "{{$randomEmail}}", pattern: `^[a-z]+\.[a-z]+@example\.com$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, unresolved := r.Resolve(tt.in)
+			if !regexp.MustCompile(tt.pattern).MatchString(got) || len(unresolved) != 0 {
+				t.Errorf("Resolve() got = %v, %v, want a match of %s", got, unresolved, tt.pattern)
+			}
+		})
+	}
+
+	first, _ := r.Resolve("{{$guid}}")
+	second, _ := r.Resolve("{{$guid}}")
+	if first == second {
+		t.Errorf("Resolve() got = %v twice, want a new value on each use", first)
+	}
+
+	r.Set(Environment, "$guid", "fixed")
+	if got, _ := r.Resolve("{{$guid}}"); got != "fixed" {
+		t.Errorf("Resolve() got = %v, want the defined variable to take precedence", got)
+	}
+}
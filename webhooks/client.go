@@ -24,7 +24,11 @@ func NewClient(restClient *rest.Client) *Client {
 }
 
 // Create sends a POST request to /webhooks.
-func (c *Client) Create(ctx context.Context, webhook Webhook) (Webhook, error) {
+func (c *Client) Create(
+	ctx context.Context,
+	webhook Webhook,
+	opts ...rest.RequestOption,
+) (Webhook, error) {
 	r, err := c.restClient.NewRequest(
 		ctx,
 		http.MethodPost,
@@ -36,7 +40,95 @@ func (c *Client) Create(ctx context.Context, webhook Webhook) (Webhook, error) {
 	}
 
 	var response webhookWrapper
-	err = c.restClient.DoRequest(r, &response)
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Webhook, err
+}
+
+// Get sends a GET request to /webhooks/:id.
+func (c *Client) Get(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Webhook, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		nil,
+	)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	var response webhookWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Webhook, err
+}
+
+// GetAll sends a GET request to /webhooks.
+func (c *Client) GetAll(
+	ctx context.Context,
+	opts ...rest.RequestOption,
+) ([]Webhook, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s%s", c.restClient.BaseURL(), path),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response webhookWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Webhooks, err
+}
+
+// Update sends a PUT request to /webhooks/:id.
+func (c *Client) Update(
+	ctx context.Context,
+	id string,
+	webhook Webhook,
+	opts ...rest.RequestOption,
+) (Webhook, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		webhookWrapper{Webhook: webhook},
+	)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	var response webhookWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
+
+	return response.Webhook, err
+}
+
+// Delete sends a DELETE request to /webhooks/:id.
+func (c *Client) Delete(
+	ctx context.Context,
+	id string,
+	opts ...rest.RequestOption,
+) (Webhook, error) {
+	r, err := c.restClient.NewRequest(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s%s/%s", c.restClient.BaseURL(), path, id),
+		nil,
+	)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	var response webhookWrapper
+	err = c.restClient.DoRequest(r, &response, opts...)
 
 	return response.Webhook, err
 }
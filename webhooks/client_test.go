@@ -4,7 +4,6 @@ package webhooks
 import (
 	"context"
 	"errors"
-	"reflect"
 	"testing"
 
 	"github.com/actatum/postman-client/rest"
@@ -41,9 +40,13 @@ func TestClient_Create(t *testing.T) {
 			Message: "name cannot be empty.",
 		}
 
-		if !reflect.DeepEqual(e, wantErr) {
+		if e.Name != wantErr.Name || e.Message != wantErr.Message {
 			t.Fatalf("c.Create() error got = %v, want %v", e, wantErr)
 		}
+
+		if !errors.Is(err, rest.ErrValidation) {
+			t.Fatalf("errors.Is() got = false, want true for %v", rest.ErrValidation)
+		}
 	})
 
 	t.Run("create webhook success", func(t *testing.T) {
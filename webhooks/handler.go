@@ -0,0 +1,119 @@
+// Package webhooks provides types/client for making requests to /webhooks.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// defaultMaxBodySize is the default limit for inbound payloads.
+const defaultMaxBodySize = 1 << 20
+
+// HandlerFunc is called with each decoded inbound payload.
+// Returning an error responds to postman with a 500 so the delivery can be retried.
+type HandlerFunc func(ctx context.Context, payload Payload) error
+
+// Handler is an http.Handler receiving postman webhook deliveries.
+// It decodes the request body into a typed Payload (*MonitorRun, *CollectionRun or *UnknownPayload)
+// and passes it to the callback.
+type Handler struct {
+	fn          HandlerFunc
+	maxBodySize int64
+	header      string
+	secret      string
+}
+
+// NewHandler returns a new instance of Handler calling fn for each delivery.
+func NewHandler(fn HandlerFunc, opts ...HandlerOption) *Handler {
+	options := handlerOptions{
+		maxBodySize: defaultMaxBodySize,
+	}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	return &Handler{
+		fn:          fn,
+		maxBodySize: options.maxBodySize,
+		header:      options.header,
+		secret:      options.secret,
+	}
+}
+
+// ServeHTTP satisfies the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.header != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(h.header)), []byte(h.secret)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	payload, err := DecodePayload(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = h.fn(r.Context(), payload); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DecodePayload decodes a raw webhook delivery into a typed Payload.
+// Monitor run results are recognized by their monitor fields, collection run results by their run object.
+// Any other json object is returned as an *UnknownPayload.
+func DecodePayload(b []byte) (Payload, error) {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, err
+	}
+
+	var payload Payload
+	switch {
+	case hasKey(keys, "monitor_uid", "monitor_name", "monitor_id"):
+		payload = &MonitorRun{}
+	case hasKey(keys, "run"):
+		payload = &CollectionRun{}
+	default:
+		return &UnknownPayload{Body: json.RawMessage(bytes.Clone(b))}, nil
+	}
+
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func hasKey(m map[string]json.RawMessage, keys ...string) bool {
+	for _, k := range keys {
+		if _, ok := m[k]; ok {
+			return true
+		}
+	}
+	return false
+}
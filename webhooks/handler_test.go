@@ -0,0 +1,168 @@
+// Package webhooks provides types/client for making requests to /webhooks.
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	monitorRun := `{
+		"collection_name": "Orders",
+		"collection_uid": "12345678-c",
+		"environment_name": "Production",
+		"environment_uid": "12345678-e",
+		"monitor_name": "Nightly",
+		"monitor_uid": "12345678-m",
+		"metrics": {"errors": 0, "totalRequests": 3, "failedTests": 1, "passedTests": 5, "totalLatency": 420},
+		"failures": [{"source": {"id": "r1", "name": "Get order"}, "error": {"name": "AssertionError",
+			"message": "expected 404 to equal 200", "test": "status is 200"}}]
+	}`
+	collectionRun := `{
+		"collection": {"id": "c1", "name": "Orders"},
+		"run": {
+			"stats": {"requests": {"total": 1}, "assertions": {"total": 2, "failed": 1}},
+			"timings": {"started": 1700000000000, "completed": 1700000001000},
+			"executions": [{"item": {"id": "r1", "name": "Get order"},
+				"response": {"code": 200, "status": "OK", "responseTime": 120},
+				"assertions": [{"assertion": "status is 200"},
+					{"assertion": "has id", "error": {"name": "AssertionError", "message": "missing id"}}]}]
+		}
+	}`
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		header     http.Header
+		opts       []HandlerOption
+		fnErr      error
+		wantStatus int
+		want       Payload
+	}{
+		{
+			name:       "monitor run",
+			method:     http.MethodPost,
+			body:       monitorRun,
+			wantStatus: http.StatusNoContent,
+			want: &MonitorRun{
+				CollectionName:  "Orders",
+				CollectionUID:   "12345678-c",
+				EnvironmentName: "Production",
+				EnvironmentUID:  "12345678-e",
+				MonitorName:     "Nightly",
+				MonitorUID:      "12345678-m",
+				Metrics:         MonitorMetrics{TotalRequests: 3, FailedTests: 1, PassedTests: 5, TotalLatency: 420},
+				Failures: []RunFailure{{
+					Source: Reference{ID: "r1", Name: "Get order"},
+					Error: TestError{
+						Name:    "AssertionError",
+						Message: "expected 404 to equal 200",
+						Test:    "status is 200",
+					},
+				}},
+			},
+		},
+		{
+			name:       "collection run",
+			method:     http.MethodPost,
+			body:       collectionRun,
+			wantStatus: http.StatusNoContent,
+			want: &CollectionRun{
+				Collection: Reference{ID: "c1", Name: "Orders"},
+				Run: Run{
+					Stats: RunStats{
+						Requests:   Stat{Total: 1},
+						Assertions: Stat{Total: 2, Failed: 1},
+					},
+					Timings: RunTimings{Started: 1700000000000, Completed: 1700000001000},
+					Executions: []Execution{{
+						Item:     Reference{ID: "r1", Name: "Get order"},
+						Response: &ExecutionResponse{Code: 200, Status: "OK", ResponseTime: 120},
+						Assertions: []Assertion{
+							{Assertion: "status is 200"},
+							{Assertion: "has id", Error: &TestError{Name: "AssertionError", Message: "missing id"}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:       "unknown payload",
+			method:     http.MethodPost,
+			body:       `{"event":"ping"}`,
+			wantStatus: http.StatusNoContent,
+			want:       &UnknownPayload{Body: []byte(`{"event":"ping"}`)},
+		},
+		{
+			name:       "invalid json",
+			method:     http.MethodPost,
+			body:       `{"monitor_uid":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "body too large",
+			method:     http.MethodPost,
+			body:       monitorRun,
+			opts:       []HandlerOption{WithMaxBodySize(16)},
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "missing secret",
+			method:     http.MethodPost,
+			body:       monitorRun,
+			opts:       []HandlerOption{WithSecretHeader("X-Webhook-Secret", "s3cret")},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "valid secret",
+			method:     http.MethodPost,
+			body:       `{}`,
+			header:     http.Header{"X-Webhook-Secret": []string{"s3cret"}},
+			opts:       []HandlerOption{WithSecretHeader("X-Webhook-Secret", "s3cret")},
+			wantStatus: http.StatusNoContent,
+			want:       &UnknownPayload{Body: []byte(`{}`)},
+		},
+		{
+			name:       "callback error",
+			method:     http.MethodPost,
+			body:       `{}`,
+			fnErr:      errors.New("boom"),
+			wantStatus: http.StatusInternalServerError,
+			want:       &UnknownPayload{Body: []byte(`{}`)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Payload
+			h := NewHandler(func(_ context.Context, p Payload) error {
+				got = p
+				return tt.fnErr
+			}, tt.opts...)
+
+			r := httptest.NewRequest(tt.method, "/hooks/postman", strings.NewReader(tt.body))
+			for k, v := range tt.header {
+				r.Header[k] = v
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("ServeHTTP() status got = %v, want %v", w.Code, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServeHTTP() payload got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package webhooks_test tests the webhooks client against the postmantest fake server.
+package webhooks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/actatum/postman-client/collections"
+	"github.com/actatum/postman-client/postmantest"
+	"github.com/actatum/postman-client/rest"
+	"github.com/actatum/postman-client/webhooks"
+)
+
+func TestClient_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	srv := postmantest.NewServer()
+	t.Cleanup(srv.Close)
+	rc := srv.RestClient()
+	c := webhooks.NewClient(rc)
+	ctx := context.Background()
+
+	collection, err := collections.NewClient(rc).Create(ctx, collections.CollectionDetails{
+		Info: collections.Info{Name: "Hooked"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wh, err := c.Create(ctx, webhooks.Webhook{
+		Name:       "Lifecycle Webhook",
+		Collection: collection.UID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.Get(ctx, wh.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != wh.ID {
+		t.Errorf("c.Get() got = %v, want %v", got.ID, wh.ID)
+	}
+
+	all, err := c.GetAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Errorf("len(c.GetAll()) got = %v, want %v", len(all), 1)
+	}
+
+	updated, err := c.Update(ctx, wh.ID, webhooks.Webhook{
+		Name:       "Renamed Webhook",
+		Collection: collection.UID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Renamed Webhook" {
+		t.Errorf("c.Update() got = %v, want %v", updated.Name, "Renamed Webhook")
+	}
+
+	if _, err = c.Delete(ctx, wh.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Get(ctx, wh.ID); !errors.Is(err, rest.ErrNotFound) {
+		t.Errorf("c.Get() error got = %v, want %v", err, rest.ErrNotFound)
+	}
+}
@@ -0,0 +1,43 @@
+// Package webhooks provides types/client for making requests to /webhooks.
+package webhooks
+
+type handlerOptions struct {
+	maxBodySize int64
+	header      string
+	secret      string
+}
+
+// HandlerOption represents functional options for configuring the Handler.
+type HandlerOption interface {
+	apply(*handlerOptions)
+}
+
+type maxBodySizeOption int64
+
+func (m maxBodySizeOption) apply(opts *handlerOptions) {
+	if m > 0 {
+		opts.maxBodySize = int64(m)
+	}
+}
+
+// WithMaxBodySize configures the maximum size in bytes of an inbound payload. Defaults to 1MB.
+func WithMaxBodySize(n int64) HandlerOption {
+	return maxBodySizeOption(n)
+}
+
+type secretHeaderOption struct {
+	header string
+	secret string
+}
+
+func (s secretHeaderOption) apply(opts *handlerOptions) {
+	opts.header = s.header
+	opts.secret = s.secret
+}
+
+// WithSecretHeader configures the handler to reject deliveries whose header doesn't hold the given secret.
+// Postman doesn't sign webhook deliveries, so set the header on the monitor or collection integration
+// sending them.
+func WithSecretHeader(header, secret string) HandlerOption {
+	return secretHeaderOption{header: header, secret: secret}
+}
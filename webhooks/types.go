@@ -1,6 +1,8 @@
 // Package webhooks provides types/client for making requests to /webhooks.
 package webhooks
 
+import "encoding/json"
+
 // Webhook represents a postman webhook.
 type Webhook struct {
 	ID         string `json:"id"` // Output only.
@@ -11,5 +13,122 @@ type Webhook struct {
 }
 
 type webhookWrapper struct {
-	Webhook Webhook `json:"webhook"`
+	Webhook  Webhook   `json:"webhook"`
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+}
+
+// Payload is an inbound webhook delivery decoded by the Handler.
+// It is one of *MonitorRun, *CollectionRun or *UnknownPayload.
+type Payload interface {
+	payload()
+}
+
+// MonitorRun represents the results of a monitor run sent by a monitor's webhook integration.
+type MonitorRun struct {
+	CollectionName  string         `json:"collection_name"`
+	CollectionUID   string         `json:"collection_uid"`
+	EnvironmentName string         `json:"environment_name,omitempty"`
+	EnvironmentUID  string         `json:"environment_uid,omitempty"`
+	MonitorName     string         `json:"monitor_name"`
+	MonitorUID      string         `json:"monitor_uid"`
+	MonitorID       string         `json:"monitor_id,omitempty"`
+	Message         string         `json:"message,omitempty"`
+	Metrics         MonitorMetrics `json:"metrics"`
+	Failures        []RunFailure   `json:"failures,omitempty"`
+}
+
+// MonitorMetrics represents the aggregated metrics of a monitor run.
+type MonitorMetrics struct {
+	Errors        int `json:"errors"`
+	Warnings      int `json:"warnings"`
+	TotalRequests int `json:"totalRequests"`
+	FailedTests   int `json:"failedTests"`
+	PassedTests   int `json:"passedTests"`
+	TotalLatency  int `json:"totalLatency"` // In milliseconds.
+}
+
+// CollectionRun represents the results of a collection run, in the newman run summary format.
+type CollectionRun struct {
+	Collection  Reference  `json:"collection"`
+	Environment *Reference `json:"environment,omitempty"`
+	Run         Run        `json:"run"`
+}
+
+// Reference identifies the collection, environment or item of a run.
+type Reference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	UID  string `json:"uid,omitempty"`
+}
+
+// Run represents the stats, timings and results of a collection run.
+type Run struct {
+	Stats      RunStats     `json:"stats"`
+	Timings    RunTimings   `json:"timings"`
+	Failures   []RunFailure `json:"failures,omitempty"`
+	Executions []Execution  `json:"executions,omitempty"`
+}
+
+// RunStats represents the counts of a collection run.
+type RunStats struct {
+	Iterations Stat `json:"iterations"`
+	Requests   Stat `json:"requests"`
+	Assertions Stat `json:"assertions"`
+}
+
+// Stat represents the total, pending and failed count of a run step.
+type Stat struct {
+	Total   int `json:"total"`
+	Pending int `json:"pending"`
+	Failed  int `json:"failed"`
+}
+
+// RunTimings represents the start and completion of a run as unix milliseconds.
+type RunTimings struct {
+	Started   int64 `json:"started"`
+	Completed int64 `json:"completed"`
+}
+
+// Execution represents the result of a single request of a collection run.
+type Execution struct {
+	Item       Reference          `json:"item"`
+	Response   *ExecutionResponse `json:"response,omitempty"`
+	Assertions []Assertion        `json:"assertions,omitempty"`
+}
+
+// ExecutionResponse represents the response received for an execution.
+type ExecutionResponse struct {
+	Code         int    `json:"code"`
+	Status       string `json:"status"`
+	ResponseTime int    `json:"responseTime"` // In milliseconds.
+	ResponseSize int    `json:"responseSize"`
+}
+
+// Assertion represents the result of a test of an execution. Error is nil when the test passed.
+type Assertion struct {
+	Assertion string     `json:"assertion"`
+	Skipped   bool       `json:"skipped,omitempty"`
+	Error     *TestError `json:"error,omitempty"`
+}
+
+// RunFailure represents a failed test or request of a run.
+type RunFailure struct {
+	Source Reference `json:"source"`
+	Error  TestError `json:"error"`
+}
+
+// TestError represents the error of a failed test or request.
+type TestError struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+	Test    string `json:"test,omitempty"`
+}
+
+// UnknownPayload holds the body of a delivery which isn't a monitor or collection run.
+type UnknownPayload struct {
+	Body json.RawMessage
 }
+
+func (*MonitorRun) payload()     {}
+func (*CollectionRun) payload()  {}
+func (*UnknownPayload) payload() {}